@@ -35,47 +35,137 @@ type AttributeStats struct {
 	Types      map[AttributeType]float64 `json:"types"`
 	InSettings []string                  `json:"inSettings,omitempty"`
 
+	Numeric *NumericStats `json:"numeric,omitempty"`
+	Strings *StringStats  `json:"strings,omitempty"`
+
+	// Examples contains a few objectIDs of records having the attribute, for each type
+	Examples map[AttributeType][]string `json:"examples,omitempty"`
+
 	Values map[interface{}]int `json:"values,omitempty"`
 }
 
+// MissingStats contains the stats for a tracked attribute missing from some records.
+type MissingStats struct {
+	Count      int      `json:"count"`
+	Percentage float64  `json:"percentage"`
+	Examples   []string `json:"examples,omitempty"`
+}
+
 // Stats contains the stats for an Algolia index.
 type Stats struct {
 	TotalRecords int
 	Attributes   map[string]*AttributeStats
+	Sizes        *SizeStats
+	// Missing is only set for the attributes tracked with `Accumulator.TrackMissing`
+	Missing map[string]*MissingStats `json:",omitempty"`
 }
 
-// ComputeStats computes the stats for the given index.
-func ComputeStats(
-	records []search.Hit,
-	settings search.SettingsResponse,
-	only string,
-) (*Stats, error) {
-	settingsMap := settingsAsMap(settings)
+// maxExamples is the maximum number of example objectIDs kept for each attribute type.
+const maxExamples = 3
+
+// Accumulator computes the stats for an index incrementally, one record at a time,
+// so that the records don't have to be kept in memory.
+type Accumulator struct {
+	settings map[string]interface{}
+	only     string
+
+	totalRecords int
+	attributes   map[string]*AttributeStats
+	values       map[string]*valueCollector
+	sizes        *sizeCollector
+	missing      map[string]*MissingStats
+}
+
+// NewAccumulator returns an accumulator for an index with the given settings.
+// If only is set, only this attribute is analyzed, and the frequency of its values is computed.
+func NewAccumulator(settings search.SettingsResponse, only string) *Accumulator {
+	return &Accumulator{
+		settings:   settingsAsMap(settings),
+		only:       only,
+		attributes: make(map[string]*AttributeStats),
+		values:     make(map[string]*valueCollector),
+		sizes:      newSizeCollector(),
+		missing:    make(map[string]*MissingStats),
+	}
+}
+
+// TrackMissing tracks the records missing the given attributes.
+// A nested attribute is only considered missing when its parent object is present.
+func (a *Accumulator) TrackMissing(attributes ...string) {
+	for _, attribute := range attributes {
+		a.missing[attribute] = &MissingStats{}
+	}
+}
+
+// Add adds a record to the stats.
+func (a *Accumulator) Add(record search.Hit) error {
+	serialized, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	a.totalRecords++
+	computeObjectStats(&Stats{Attributes: a.attributes}, record.ObjectID, "", record.AdditionalProperties, a.only)
+	collectValues(a.values, "", record.AdditionalProperties, a.only)
+	a.sizes.add(RecordSize{ObjectID: record.ObjectID, Size: len(serialized)})
+
+	for attribute, missing := range a.missing {
+		if isMissing(record, attribute) {
+			missing.Count++
+			if len(missing.Examples) < maxExamples {
+				missing.Examples = append(missing.Examples, record.ObjectID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Stats returns the stats for the records added so far.
+func (a *Accumulator) Stats() *Stats {
 	stats := &Stats{
-		Attributes:   make(map[string]*AttributeStats),
-		TotalRecords: len(records),
+		Attributes:   make(map[string]*AttributeStats, len(a.attributes)),
+		TotalRecords: a.totalRecords,
+		Sizes:        a.sizes.stats(),
 	}
 
-	for _, record := range records {
-		stats = computeObjectStats(stats, "", record.AdditionalProperties, only)
+	if len(a.missing) > 0 {
+		stats.Missing = make(map[string]*MissingStats, len(a.missing))
+		for attribute, missing := range a.missing {
+			stats.Missing[attribute] = &MissingStats{
+				Count:      missing.Count,
+				Percentage: float64(missing.Count) * 100 / float64(stats.TotalRecords),
+				Examples:   append([]string{}, missing.Examples...),
+			}
+		}
 	}
 
-	for key, value := range stats.Attributes {
+	for key, counts := range a.attributes {
+		value := &AttributeStats{
+			Count:    counts.Count,
+			Types:    make(map[AttributeType]float64, len(counts.Types)),
+			Values:   make(map[interface{}]int),
+			Examples: make(map[AttributeType][]string, len(counts.Examples)),
+		}
+		for typeKey, examples := range counts.Examples {
+			value.Examples[typeKey] = append([]string{}, examples...)
+		}
+
 		// Calculate the percentage of each attribute rounded up
 		if !strings.Contains(key, ".") {
 			value.Percentage = float64(value.Count) * 100 / float64(stats.TotalRecords)
 		} else {
 			// If the attribute is a nested one, compute the percentage based on the parent attribute count
-			value.Percentage = float64(value.Count) * 100 / float64(stats.Attributes[parentKey(key)].Count)
+			value.Percentage = float64(value.Count) * 100 / float64(a.attributes[parentKey(key)].Count)
 		}
 
 		// Calculate the percentage of each type rounded up
-		for typeKey, typeValue := range value.Types {
+		for typeKey, typeValue := range counts.Types {
 			if !strings.Contains(key, ".") {
 				value.Types[typeKey] = float64(int(typeValue*100)) / float64(stats.TotalRecords)
 			} else {
 				// If the attribute is a nested one, compute the percentage based on the parent attribute count
-				value.Types[typeKey] = float64(int(typeValue*100)) / float64(stats.Attributes[parentKey(key)].Count)
+				value.Types[typeKey] = float64(int(typeValue*100)) / float64(a.attributes[parentKey(key)].Count)
 			}
 		}
 
@@ -86,16 +176,41 @@ func ComputeStats(
 		}
 
 		// Add the settings where the attribute is present
-		value.InSettings = inSettings(settingsMap, key)
+		value.InSettings = inSettings(a.settings, key)
+
+		// Add the distribution of the numeric and string values
+		if c, ok := a.values[key]; ok {
+			value.Numeric = c.numericStats()
+			value.Strings = c.stringStats()
+			if c.frequencies != nil {
+				value.Values = c.frequencies.counts()
+			}
+		}
 
 		stats.Attributes[key] = value
 	}
 
-	return stats, nil
+	return stats
+}
+
+// ComputeStats computes the stats for the given records.
+func ComputeStats(
+	records []search.Hit,
+	settings search.SettingsResponse,
+	only string,
+) (*Stats, error) {
+	accumulator := NewAccumulator(settings, only)
+	for _, record := range records {
+		if err := accumulator.Add(record); err != nil {
+			return nil, err
+		}
+	}
+	return accumulator.Stats(), nil
 }
 
 // computeObjectStats computes the stats for the given object.
-func computeObjectStats(s *Stats, p string, o map[string]interface{}, only string) *Stats {
+// If objectID is set, it's kept as an example for the type of each attribute.
+func computeObjectStats(s *Stats, objectID string, p string, o map[string]interface{}, only string) *Stats {
 	for key, value := range o {
 		if only != "" && only != key {
 			continue
@@ -111,7 +226,7 @@ func computeObjectStats(s *Stats, p string, o map[string]interface{}, only strin
 		if getType(value) == Object {
 			v, ok := value.(map[string]interface{})
 			if ok {
-				s = computeObjectStats(s, fullPath, v, only)
+				s = computeObjectStats(s, objectID, fullPath, v, only)
 			}
 		}
 
@@ -124,14 +239,13 @@ func computeObjectStats(s *Stats, p string, o map[string]interface{}, only strin
 		s.Attributes[fullPath].Count++
 		s.Attributes[fullPath].Types[getType(value)]++
 
-		if only != "" {
-			switch getType(value) {
-			case String, Numeric, Boolean:
-				s.Attributes[fullPath].Values[value]++
-			case Array:
-				for _, v := range value.([]interface{}) {
-					s.Attributes[fullPath].Values[v]++
-				}
+		if objectID != "" {
+			if s.Attributes[fullPath].Examples == nil {
+				s.Attributes[fullPath].Examples = make(map[AttributeType][]string)
+			}
+			examples := s.Attributes[fullPath].Examples[getType(value)]
+			if len(examples) < maxExamples {
+				s.Attributes[fullPath].Examples[getType(value)] = append(examples, objectID)
 			}
 		}
 	}
@@ -139,6 +253,31 @@ func computeObjectStats(s *Stats, p string, o map[string]interface{}, only strin
 	return s
 }
 
+// isMissing returns true if the record has the parent of the given attribute, but not the attribute itself.
+func isMissing(record search.Hit, attribute string) bool {
+	// The objectID isn't in the additional properties of a hit
+	if attribute == "objectID" {
+		return record.ObjectID == ""
+	}
+	o := record.AdditionalProperties
+	path := strings.Split(attribute, ".")
+	for i, key := range path {
+		value, ok := o[key]
+		if !ok {
+			// Only the last key of the path is considered missing
+			return i == len(path)-1
+		}
+		if i == len(path)-1 {
+			return false
+		}
+		o, ok = value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+	}
+	return false
+}
+
 // getType returns the type of the given value
 func getType(value interface{}) AttributeType {
 	switch value.(type) {
@@ -185,6 +324,7 @@ func inSettings(s map[string]interface{}, key string) []string {
 		"exact(%s)",
 		"filterOnly(%s)",
 		"afterDistinct(%s)",
+		"asc(%s)",
 		"desc(%s)",
 	}
 	for s, v := range s {
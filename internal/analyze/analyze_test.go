@@ -3,6 +3,7 @@ package analyze
 import (
 	"testing"
 
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -153,7 +154,7 @@ func Test_computeObjectStats(t *testing.T) {
 			stats := Stats{
 				Attributes: make(map[string]*AttributeStats),
 			}
-			out := computeObjectStats(&stats, "", s.Input, "")
+			out := computeObjectStats(&stats, "", "", s.Input, "")
 			require.NotNil(t, out)
 			assert.Equal(t, s.Output, out)
 		})
@@ -251,3 +252,53 @@ func Test_inSettings(t *testing.T) {
 		})
 	}
 }
+
+func Test_Accumulator(t *testing.T) {
+	records := []search.Hit{
+		{ObjectID: "1", AdditionalProperties: map[string]interface{}{"genre": "drama", "year": 1994.0}},
+		{ObjectID: "2", AdditionalProperties: map[string]interface{}{"genre": "comedy", "year": 2001.0}},
+		{ObjectID: "3", AdditionalProperties: map[string]interface{}{"genre": []interface{}{"drama", "comedy"}}},
+	}
+	settings := search.SettingsResponse{AttributesForFaceting: []string{"genre"}}
+
+	scenarios := []struct {
+		Name   string
+		Only   string
+		Values map[string]map[interface{}]int
+	}{
+		{
+			Name:   "all attributes",
+			Values: map[string]map[interface{}]int{"genre": {}, "year": {}},
+		},
+		{
+			Name:   "only genre",
+			Only:   "genre",
+			Values: map[string]map[interface{}]int{"genre": {"drama": 2, "comedy": 2}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			accumulator := NewAccumulator(settings, s.Only)
+			for _, r := range records {
+				require.NoError(t, accumulator.Add(r))
+			}
+			stats := accumulator.Stats()
+
+			assert.Equal(t, 3, stats.TotalRecords)
+			require.Len(t, stats.Attributes, len(s.Values))
+			for key, values := range s.Values {
+				assert.Equal(t, values, stats.Attributes[key].Values)
+			}
+
+			genre := stats.Attributes["genre"]
+			assert.Equal(t, 100.0, genre.Percentage)
+			assert.Equal(t, []string{"attributesForFaceting"}, genre.InSettings)
+			assert.Equal(t, 2, genre.Strings.Cardinality)
+			assert.Equal(t, 3, stats.Sizes.Histogram[0].Count)
+
+			// Stats can be computed several times
+			assert.Equal(t, stats, accumulator.Stats())
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package analyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Schema is the expected schema of the records of an index.
+type Schema struct {
+	// Attributes maps the (dotted) path of the declared attributes to their allowed types
+	Attributes map[string][]AttributeType
+	// Required contains the attributes that must be present in every record
+	// (for nested attributes, in every record where their parent object is present)
+	Required []string
+}
+
+// DriftKind is an enum for the different kinds of drift between the records and a schema.
+type DriftKind string
+
+const (
+	// NewAttribute is an attribute present in the records but not declared in the schema.
+	NewAttribute DriftKind = "new"
+	// MissingAttribute is a required attribute missing from some records.
+	MissingAttribute DriftKind = "missing"
+	// TypeMismatch is an attribute with a type not allowed by the schema.
+	TypeMismatch DriftKind = "type"
+)
+
+// Drift is a difference between the records and their expected schema.
+type Drift struct {
+	Kind      DriftKind       `json:"kind"`
+	Attribute string          `json:"attribute"`
+	Expected  []AttributeType `json:"expected,omitempty"`
+	Actual    AttributeType   `json:"actual,omitempty"`
+	// Percentage is the percentage of all the records affected by the drift
+	Percentage float64  `json:"percentage"`
+	Examples   []string `json:"examples,omitempty"`
+}
+
+// ParseSchema parses a JSON Schema, or a simple map of attribute names to types.
+//
+// In the simple format, the values are a type name or a list of type names,
+// nested attributes use dotted names, and attributes are required unless their name ends with "?":
+//
+//	{"title": "string", "year": ["numeric", "null"], "meta.rating?": "numeric"}
+func ParseSchema(b []byte) (*Schema, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	schema := &Schema{Attributes: make(map[string][]AttributeType)}
+	_, hasProperties := raw["properties"]
+	_, hasSchema := raw["$schema"]
+	if hasProperties || hasSchema {
+		if err := parseJSONSchema(schema, "", raw); err != nil {
+			return nil, err
+		}
+	} else {
+		for key, value := range raw {
+			name := strings.TrimSuffix(key, "?")
+			types, err := parseTypes(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid type for attribute %q: %w", name, err)
+			}
+			schema.Attributes[name] = types
+			if !strings.HasSuffix(key, "?") {
+				schema.Required = append(schema.Required, name)
+			}
+		}
+		// The parents of nested attributes are implicitly declared as objects
+		for name := range schema.Attributes {
+			for parent := parentKey(name); parent != ""; parent = parentKey(parent) {
+				if _, ok := schema.Attributes[parent]; !ok {
+					schema.Attributes[parent] = []AttributeType{Object}
+				}
+			}
+		}
+	}
+	sort.Strings(schema.Required)
+
+	return schema, nil
+}
+
+// parseJSONSchema adds the properties of a JSON Schema object to the schema.
+func parseJSONSchema(schema *Schema, p string, o map[string]interface{}) error {
+	properties, _ := o["properties"].(map[string]interface{})
+	for key, value := range properties {
+		fullPath := key
+		if p != "" {
+			fullPath = fmt.Sprintf("%s.%s", p, key)
+		}
+
+		property, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid schema for attribute %q", fullPath)
+		}
+
+		var types []AttributeType
+		if t, ok := property["type"]; ok {
+			var err error
+			types, err = parseTypes(t)
+			if err != nil {
+				return fmt.Errorf("invalid type for attribute %q: %w", fullPath, err)
+			}
+		}
+		schema.Attributes[fullPath] = types
+
+		if err := parseJSONSchema(schema, fullPath, property); err != nil {
+			return err
+		}
+	}
+
+	required, _ := o["required"].([]interface{})
+	for _, r := range required {
+		name, ok := r.(string)
+		if !ok {
+			continue
+		}
+		if p != "" {
+			name = fmt.Sprintf("%s.%s", p, name)
+		}
+		schema.Required = append(schema.Required, name)
+	}
+
+	return nil
+}
+
+// parseTypes parses a type name or a list of type names.
+func parseTypes(value interface{}) ([]AttributeType, error) {
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []interface{}:
+		for _, n := range v {
+			name, ok := n.(string)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a type name", n)
+			}
+			names = append(names, name)
+		}
+	default:
+		return nil, fmt.Errorf("%v is not a type name", value)
+	}
+
+	var types []AttributeType
+	for _, name := range names {
+		switch strings.ToLower(name) {
+		case "string":
+			types = append(types, String)
+		case "numeric", "number", "integer":
+			types = append(types, Numeric)
+		case "boolean", "bool":
+			types = append(types, Boolean)
+		case "array":
+			types = append(types, Array)
+		case "object":
+			types = append(types, Object)
+		case "null":
+			types = append(types, Null)
+		default:
+			return nil, fmt.Errorf("unknown type %q", name)
+		}
+	}
+	return types, nil
+}
+
+// CheckSchema compares the stats of an index with its expected schema.
+// The missing required attributes are only reported if they were tracked with `Accumulator.TrackMissing`.
+// The percentages of the drifts are relative to the total number of records, nested attributes included.
+func CheckSchema(stats *Stats, schema *Schema) []Drift {
+	drifts := []Drift{}
+
+	keys := make([]string, 0, len(stats.Attributes))
+	for key := range stats.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := stats.Attributes[key]
+		expected, declared := schema.Attributes[key]
+		if !declared {
+			// Only report the top-most new attribute, and allow any property in declared objects without properties
+			if parent := parentKey(key); parent != "" {
+				if _, parentDeclared := schema.Attributes[parent]; !parentDeclared || !hasDeclaredChild(schema, parent) {
+					continue
+				}
+			}
+			drifts = append(drifts, Drift{
+				Kind:       NewAttribute,
+				Attribute:  key,
+				Percentage: shareOfRecords(stats, key, value.Percentage),
+				Examples:   allExamples(value),
+			})
+			continue
+		}
+
+		if len(expected) == 0 {
+			continue
+		}
+		types := make([]AttributeType, 0, len(value.Types))
+		for t := range value.Types {
+			types = append(types, t)
+		}
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+		for _, t := range types {
+			if t == Undefined || containsType(expected, t) {
+				continue
+			}
+			drifts = append(drifts, Drift{
+				Kind:       TypeMismatch,
+				Attribute:  key,
+				Expected:   expected,
+				Actual:     t,
+				Percentage: shareOfRecords(stats, key, value.Types[t]),
+				Examples:   value.Examples[t],
+			})
+		}
+	}
+
+	for _, attribute := range schema.Required {
+		missing, ok := stats.Missing[attribute]
+		if !ok || missing.Count == 0 {
+			continue
+		}
+		drifts = append(drifts, Drift{
+			Kind:       MissingAttribute,
+			Attribute:  attribute,
+			Expected:   schema.Attributes[attribute],
+			Percentage: missing.Percentage,
+			Examples:   missing.Examples,
+		})
+	}
+
+	return drifts
+}
+
+// shareOfRecords converts a percentage of the records with the parent of a nested attribute
+// to a percentage of all the records.
+func shareOfRecords(stats *Stats, key string, percentage float64) float64 {
+	parent, ok := stats.Attributes[parentKey(key)]
+	if !strings.Contains(key, ".") || !ok || stats.TotalRecords == 0 {
+		return percentage
+	}
+	return percentage * float64(parent.Count) / float64(stats.TotalRecords)
+}
+
+// hasDeclaredChild returns true if the schema declares nested attributes of the given attribute.
+func hasDeclaredChild(schema *Schema, attribute string) bool {
+	for key := range schema.Attributes {
+		if strings.HasPrefix(key, attribute+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// allExamples returns the example objectIDs of an attribute, for all its types.
+func allExamples(value *AttributeStats) []string {
+	var examples []string
+	for _, e := range value.Examples {
+		examples = append(examples, e...)
+	}
+	sort.Strings(examples)
+	if len(examples) > maxExamples {
+		examples = examples[:maxExamples]
+	}
+	return examples
+}
+
+// containsType returns true if the slice contains the given type
+func containsType(types []AttributeType, t AttributeType) bool {
+	for _, e := range types {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package analyze
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseSchema(t *testing.T) {
+	expected := &Schema{
+		Attributes: map[string][]AttributeType{
+			"title":       {String},
+			"year":        {Numeric, Null},
+			"meta":        {Object},
+			"meta.rating": {Numeric},
+		},
+		Required: []string{"meta.rating", "title"},
+	}
+
+	scenarios := []struct {
+		Name  string
+		Input string
+	}{
+		{
+			Name:  "type map",
+			Input: `{"title": "string", "year?": ["number", "null"], "meta.rating": "numeric"}`,
+		},
+		{
+			Name: "JSON Schema",
+			Input: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"properties": {
+					"title": {"type": "string"},
+					"year": {"type": ["integer", "null"]},
+					"meta": {"type": "object", "properties": {"rating": {"type": "number"}}, "required": ["rating"]}
+				},
+				"required": ["title"]
+			}`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			schema, err := ParseSchema([]byte(s.Input))
+			require.NoError(t, err)
+			assert.Equal(t, expected, schema)
+		})
+	}
+
+	_, err := ParseSchema([]byte(`{"title": "text"}`))
+	assert.EqualError(t, err, `invalid type for attribute "title": unknown type "text"`)
+}
+
+func Test_CheckSchema(t *testing.T) {
+	schema, err := ParseSchema(
+		[]byte(`{"title": "string", "year": "numeric", "meta.rating": "numeric", "extra?": "object"}`),
+	)
+	require.NoError(t, err)
+
+	records := []search.Hit{
+		{ObjectID: "1", AdditionalProperties: map[string]interface{}{
+			"title": "Heat", "year": 1995.0, "meta": map[string]interface{}{"rating": 8.3},
+		}},
+		{ObjectID: "2", AdditionalProperties: map[string]interface{}{
+			"title": "Ronin", "year": "1998", "meta": map[string]interface{}{},
+		}},
+		{ObjectID: "3", AdditionalProperties: map[string]interface{}{
+			"year": 2004.0, "budget": 1.0, "extra": map[string]interface{}{"anything": true},
+		}},
+		{ObjectID: "4", AdditionalProperties: map[string]interface{}{
+			"title": "Thief", "year": 1981.0, "meta": map[string]interface{}{"rating": 7.4, "votes": 10.0},
+		}},
+	}
+	accumulator := NewAccumulator(search.SettingsResponse{}, "")
+	accumulator.TrackMissing(schema.Required...)
+	for _, r := range records {
+		require.NoError(t, accumulator.Add(r))
+	}
+
+	assert.Equal(t, []Drift{
+		{Kind: NewAttribute, Attribute: "budget", Percentage: 25, Examples: []string{"3"}},
+		{Kind: NewAttribute, Attribute: "meta.votes", Percentage: 25, Examples: []string{"4"}},
+		{Kind: TypeMismatch, Attribute: "year", Expected: []AttributeType{Numeric}, Actual: String, Percentage: 25, Examples: []string{"2"}},
+		{Kind: MissingAttribute, Attribute: "meta.rating", Expected: []AttributeType{Numeric}, Percentage: 25, Examples: []string{"2"}},
+		{Kind: MissingAttribute, Attribute: "title", Expected: []AttributeType{String}, Percentage: 25, Examples: []string{"3"}},
+	}, CheckSchema(accumulator.Stats(), schema))
+}
+
+func Test_CheckSchema_objectID(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{"objectID": "string", "title": "string"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"objectID", "title"}, schema.Required)
+
+	accumulator := NewAccumulator(search.SettingsResponse{}, "")
+	accumulator.TrackMissing(schema.Required...)
+	for _, r := range []search.Hit{
+		{ObjectID: "1", AdditionalProperties: map[string]interface{}{"title": "Heat"}},
+		{ObjectID: "2", AdditionalProperties: map[string]interface{}{"title": "Ronin"}},
+	} {
+		require.NoError(t, accumulator.Add(r))
+	}
+
+	assert.Equal(t, []Drift{}, CheckSchema(accumulator.Stats(), schema))
+}
+
+func Test_CheckSchema_nestedPercentage(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{"meta?": "object", "meta.rating?": "numeric"}`))
+	require.NoError(t, err)
+
+	accumulator := NewAccumulator(search.SettingsResponse{}, "")
+	for _, r := range []search.Hit{
+		{ObjectID: "1", AdditionalProperties: map[string]interface{}{"meta": map[string]interface{}{"rating": "8"}}},
+		{ObjectID: "2", AdditionalProperties: map[string]interface{}{"meta": map[string]interface{}{"votes": 10.0}}},
+		{ObjectID: "3", AdditionalProperties: map[string]interface{}{}},
+		{ObjectID: "4", AdditionalProperties: map[string]interface{}{}},
+	} {
+		require.NoError(t, accumulator.Add(r))
+	}
+
+	// Both drifts affect half of the records with a meta object, so a quarter of all the records
+	assert.Equal(t, []Drift{
+		{Kind: TypeMismatch, Attribute: "meta.rating", Expected: []AttributeType{Numeric}, Actual: String, Percentage: 25, Examples: []string{"1"}},
+		{Kind: NewAttribute, Attribute: "meta.votes", Percentage: 25, Examples: []string{"2"}},
+	}, CheckSchema(accumulator.Stats(), schema))
+}
@@ -0,0 +1,125 @@
+package analyze
+
+import (
+	"sort"
+)
+
+// LargestRecordsCount is the number of largest records reported in the size stats.
+const LargestRecordsCount = 10
+
+// sizeBucketBounds are the upper bounds (exclusive, in bytes) of the record size histogram buckets.
+// The last bucket has no upper bound.
+var sizeBucketBounds = []int{1_000, 2_000, 5_000, 10_000, 20_000, 50_000, 100_000}
+
+// SizeBucket is a bucket of the record size histogram.
+// To is 0 for the last bucket, which has no upper bound.
+type SizeBucket struct {
+	From  int `json:"from"`
+	To    int `json:"to,omitempty"`
+	Count int `json:"count"`
+}
+
+// RecordSize is the serialized size of a record, in bytes.
+type RecordSize struct {
+	ObjectID string `json:"objectID"`
+	Size     int    `json:"size"`
+}
+
+// SizeStats contains the distribution of the serialized size of the records.
+type SizeStats struct {
+	Min       int          `json:"min"`
+	Max       int          `json:"max"`
+	Mean      float64      `json:"mean"`
+	P50       int          `json:"p50"`
+	P95       int          `json:"p95"`
+	Histogram []SizeBucket `json:"histogram"`
+	Largest   []RecordSize `json:"largest"`
+}
+
+// sizeCollector collects the sizes of the records, with a bounded memory footprint.
+type sizeCollector struct {
+	count   int
+	sum     int
+	min     int
+	max     int
+	buckets []int
+	sample  *reservoir
+	// largest is sorted by size, largest first
+	largest []RecordSize
+}
+
+func newSizeCollector() *sizeCollector {
+	return &sizeCollector{
+		buckets: make([]int, len(sizeBucketBounds)+1),
+		sample:  newReservoir(),
+	}
+}
+
+func (c *sizeCollector) add(r RecordSize) {
+	if c.count == 0 || r.Size < c.min {
+		c.min = r.Size
+	}
+	if r.Size > c.max {
+		c.max = r.Size
+	}
+	c.count++
+	c.sum += r.Size
+	c.buckets[sizeBucketIndex(r.Size)]++
+	c.sample.add(float64(r.Size))
+
+	// Insert the record among the largest ones, ties broken by objectID to have a consistent output
+	i := sort.Search(len(c.largest), func(i int) bool {
+		if c.largest[i].Size != r.Size {
+			return c.largest[i].Size < r.Size
+		}
+		return c.largest[i].ObjectID > r.ObjectID
+	})
+	if i < LargestRecordsCount {
+		c.largest = append(c.largest, RecordSize{})
+		copy(c.largest[i+1:], c.largest[i:])
+		c.largest[i] = r
+		if len(c.largest) > LargestRecordsCount {
+			c.largest = c.largest[:LargestRecordsCount]
+		}
+	}
+}
+
+// stats computes the size stats of the collected records.
+// It returns nil if there are no records.
+func (c *sizeCollector) stats() *SizeStats {
+	if c.count == 0 {
+		return nil
+	}
+
+	stats := &SizeStats{
+		Min:     c.min,
+		Max:     c.max,
+		Mean:    float64(c.sum) / float64(c.count),
+		P50:     int(c.sample.percentile(50)),
+		P95:     int(c.sample.percentile(95)),
+		Largest: append([]RecordSize{}, c.largest...),
+	}
+
+	// Only keep the buckets up to the last non-empty one
+	from := 0
+	for i := 0; i <= sizeBucketIndex(c.max); i++ {
+		bucket := SizeBucket{From: from, Count: c.buckets[i]}
+		if i < len(sizeBucketBounds) {
+			bucket.To = sizeBucketBounds[i]
+			from = bucket.To
+		}
+		stats.Histogram = append(stats.Histogram, bucket)
+	}
+
+	return stats
+}
+
+// sizeBucketIndex returns the index of the histogram bucket for the given size.
+func sizeBucketIndex(size int) int {
+	for i, bound := range sizeBucketBounds {
+		if size < bound {
+			return i
+		}
+	}
+	return len(sizeBucketBounds)
+}
@@ -0,0 +1,37 @@
+package analyze
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sizeCollector(t *testing.T) {
+	c := newSizeCollector()
+	assert.Nil(t, c.stats())
+
+	// Add the records in a shuffled order
+	for _, i := range []int{7, 3, 20, 11, 1, 15, 9, 18, 2, 13, 6, 17, 4, 10, 19, 5, 14, 8, 16, 12} {
+		c.add(RecordSize{ObjectID: fmt.Sprintf("%d", i), Size: i * 500})
+	}
+
+	stats := c.stats()
+	require.NotNil(t, stats)
+	assert.Equal(t, 500, stats.Min)
+	assert.Equal(t, 10_000, stats.Max)
+	assert.Equal(t, 5250.0, stats.Mean)
+	assert.Equal(t, 5000, stats.P50)
+	assert.Equal(t, 9500, stats.P95)
+	assert.Equal(t, []SizeBucket{
+		{From: 0, To: 1_000, Count: 1},
+		{From: 1_000, To: 2_000, Count: 2},
+		{From: 2_000, To: 5_000, Count: 6},
+		{From: 5_000, To: 10_000, Count: 10},
+		{From: 10_000, To: 20_000, Count: 1},
+	}, stats.Histogram)
+	require.Len(t, stats.Largest, LargestRecordsCount)
+	assert.Equal(t, RecordSize{ObjectID: "20", Size: 10_000}, stats.Largest[0])
+	assert.Equal(t, RecordSize{ObjectID: "11", Size: 5_500}, stats.Largest[LargestRecordsCount-1])
+}
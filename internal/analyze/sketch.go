@@ -0,0 +1,206 @@
+package analyze
+
+import (
+	"container/heap"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"sort"
+)
+
+const (
+	// reservoirSize is the number of values sampled to compute percentiles.
+	// Percentiles are exact up to this number of values.
+	reservoirSize = 10_000
+	// exactCardinalityLimit is the number of distinct values counted exactly,
+	// before falling back to a HyperLogLog estimate.
+	exactCardinalityLimit = 1_000
+	// hllPrecision is the number of bits used to index the HyperLogLog registers.
+	hllPrecision = 14
+	// maxFrequencies is the maximum number of distinct values tracked when analyzing a single attribute.
+	// Counts are exact up to this number of distinct values.
+	maxFrequencies = 10_000
+)
+
+// reservoir keeps a uniform random sample of bounded size of the values added to it.
+type reservoir struct {
+	seen   int
+	sample []float64
+	rand   *rand.Rand
+}
+
+func newReservoir() *reservoir {
+	// The seed is fixed to have a consistent output between runs
+	return &reservoir{rand: rand.New(rand.NewPCG(1, 2))}
+}
+
+func (r *reservoir) add(v float64) {
+	r.seen++
+	if len(r.sample) < reservoirSize {
+		r.sample = append(r.sample, v)
+		return
+	}
+	if i := r.rand.IntN(r.seen); i < reservoirSize {
+		r.sample[i] = v
+	}
+}
+
+// percentile returns the p-th percentile of the sampled values, using the nearest-rank method.
+func (r *reservoir) percentile(p float64) float64 {
+	if len(r.sample) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(r.sample))
+	copy(sorted, r.sample)
+	sort.Float64s(sorted)
+	return sorted[percentileIndex(len(sorted), p)]
+}
+
+// cardinalityCounter counts distinct strings exactly up to exactCardinalityLimit,
+// and estimates the cardinality with a HyperLogLog sketch afterwards.
+type cardinalityCounter struct {
+	exact map[string]struct{}
+	hll   *hyperLogLog
+}
+
+func newCardinalityCounter() *cardinalityCounter {
+	return &cardinalityCounter{exact: make(map[string]struct{})}
+}
+
+func (c *cardinalityCounter) add(s string) {
+	if c.hll != nil {
+		c.hll.add(s)
+		return
+	}
+	c.exact[s] = struct{}{}
+	if len(c.exact) > exactCardinalityLimit {
+		c.hll = &hyperLogLog{}
+		for v := range c.exact {
+			c.hll.add(v)
+		}
+		c.exact = nil
+	}
+}
+
+func (c *cardinalityCounter) count() int {
+	if c.hll != nil {
+		return c.hll.count()
+	}
+	return len(c.exact)
+}
+
+// hyperLogLog estimates the number of distinct strings added to it, with a fixed memory footprint.
+type hyperLogLog struct {
+	registers [1 << hllPrecision]uint8
+}
+
+func (h *hyperLogLog) add(s string) {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(s))
+	x := mix64(hasher.Sum64())
+
+	i := x >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1)) + 1)
+	if rank > h.registers[i] {
+		h.registers[i] = rank
+	}
+}
+
+func (h *hyperLogLog) count() int {
+	m := float64(len(h.registers))
+	var sum float64
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Pow(2, -float64(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// Linear counting is more accurate for small cardinalities
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+// mix64 improves the distribution of the bits of a hash (splitmix64 finalizer).
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// frequencies counts the occurrences of the most frequent values, with the Space-Saving algorithm.
+// Once maxFrequencies distinct values are tracked, a new value replaces the least frequent one.
+type frequencies struct {
+	entries frequencyHeap
+	index   map[interface{}]*frequencyEntry
+}
+
+type frequencyEntry struct {
+	value interface{}
+	count int
+	index int
+}
+
+func newFrequencies() *frequencies {
+	return &frequencies{index: make(map[interface{}]*frequencyEntry)}
+}
+
+func (f *frequencies) add(v interface{}) {
+	if e, ok := f.index[v]; ok {
+		e.count++
+		heap.Fix(&f.entries, e.index)
+		return
+	}
+	if len(f.entries) < maxFrequencies {
+		e := &frequencyEntry{value: v, count: 1}
+		f.index[v] = e
+		heap.Push(&f.entries, e)
+		return
+	}
+	e := f.entries[0]
+	delete(f.index, e.value)
+	e.value = v
+	e.count++
+	f.index[v] = e
+	heap.Fix(&f.entries, 0)
+}
+
+func (f *frequencies) counts() map[interface{}]int {
+	result := make(map[interface{}]int, len(f.entries))
+	for _, e := range f.entries {
+		result[e.value] = e.count
+	}
+	return result
+}
+
+// frequencyHeap is a min-heap of frequency entries, implementing heap.Interface.
+type frequencyHeap []*frequencyEntry
+
+func (h frequencyHeap) Len() int           { return len(h) }
+func (h frequencyHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h frequencyHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *frequencyHeap) Push(x any) {
+	e := x.(*frequencyEntry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *frequencyHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
@@ -0,0 +1,63 @@
+package analyze
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_reservoir(t *testing.T) {
+	r := newReservoir()
+	for i := 1; i <= 10*reservoirSize; i++ {
+		r.add(float64(i))
+	}
+
+	assert.Len(t, r.sample, reservoirSize)
+	assert.InEpsilon(t, 5*reservoirSize, r.percentile(50), 0.05)
+	assert.InEpsilon(t, 9.5*reservoirSize, r.percentile(95), 0.05)
+}
+
+func Test_cardinalityCounter(t *testing.T) {
+	scenarios := []struct {
+		Name     string
+		Distinct int
+		Epsilon  float64
+	}{
+		{Name: "exact", Distinct: exactCardinalityLimit, Epsilon: 0},
+		{Name: "estimated", Distinct: 100_000, Epsilon: 0.02},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			c := newCardinalityCounter()
+			// Add each value twice
+			for i := 0; i < 2*s.Distinct; i++ {
+				c.add(fmt.Sprintf("value-%d", i%s.Distinct))
+			}
+			if s.Epsilon == 0 {
+				assert.Equal(t, s.Distinct, c.count())
+			} else {
+				assert.InEpsilon(t, s.Distinct, c.count(), s.Epsilon)
+			}
+		})
+	}
+}
+
+func Test_frequencies(t *testing.T) {
+	f := newFrequencies()
+	f.add("foo")
+	f.add("foo")
+	f.add(true)
+	f.add(1.0)
+	assert.Equal(t, map[interface{}]int{"foo": 2, true: 1, 1.0: 1}, f.counts())
+
+	// Frequent values are kept once the sketch is full
+	for i := 0; i < 2*maxFrequencies; i++ {
+		f.add(i)
+		f.add("foo")
+	}
+	counts := f.counts()
+	assert.Len(t, counts, maxFrequencies)
+	assert.Equal(t, 2+2*maxFrequencies, counts["foo"])
+}
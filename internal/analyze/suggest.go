@@ -0,0 +1,276 @@
+package analyze
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+
+	"github.com/algolia/cli/pkg/utils"
+)
+
+const (
+	// facetMaxCardinality is the maximum number of distinct values for a string attribute to be suggested as a facet.
+	facetMaxCardinality = 100
+	// facetMinPercentage is the minimum percentage of records an attribute must be present in to be suggested as a facet.
+	facetMinPercentage = 10
+	// customRankingMinPercentage is the minimum percentage of records a numeric attribute must be present in
+	// (with a numeric value) to be suggested for the custom ranking.
+	customRankingMinPercentage = 90
+	// searchableMinPercentage is the minimum percentage of records a searchable attribute should be present in.
+	searchableMinPercentage = 50
+)
+
+// SuggestionAction is an enum for the different kinds of suggestions.
+type SuggestionAction string
+
+const (
+	// Add suggests adding the attribute to the setting.
+	Add SuggestionAction = "add"
+	// Remove suggests removing the attribute from the setting.
+	Remove SuggestionAction = "remove"
+	// Unknown flags an attribute of the setting that isn't present in any record.
+	Unknown SuggestionAction = "unknown"
+)
+
+// Suggestion is a single settings change suggested from the stats of an index.
+type Suggestion struct {
+	Action    SuggestionAction `json:"action"`
+	Setting   string           `json:"setting"`
+	Attribute string           `json:"attribute"`
+	Reason    string           `json:"reason"`
+}
+
+// Suggestions contains the suggested settings changes for an index.
+// Patch only contains the settings that changed, in a format accepted by `settings import`.
+type Suggestions struct {
+	Suggestions []Suggestion           `json:"suggestions"`
+	Patch       map[string]interface{} `json:"patch"`
+}
+
+// attributeModifier matches the modifiers wrapping attributes in settings, like `unordered(title)`.
+var attributeModifier = regexp.MustCompile(`^[a-zA-Z]+\((.*)\)$`)
+
+// Suggest computes settings changes based on the stats of an index and its current settings.
+func Suggest(stats *Stats, settings search.SettingsResponse) *Suggestions {
+	result := &Suggestions{
+		Suggestions: []Suggestion{},
+		Patch:       make(map[string]interface{}),
+	}
+
+	keys := make([]string, 0, len(stats.Attributes))
+	for key := range stats.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Low-cardinality strings that aren't facets yet
+	facets := append([]string{}, settings.AttributesForFaceting...)
+	for _, key := range keys {
+		value := stats.Attributes[key]
+		if value.Strings == nil || utils.Contains(value.InSettings, "attributesForFaceting") {
+			continue
+		}
+		if value.Percentage < facetMinPercentage ||
+			value.Strings.Cardinality < 2 ||
+			value.Strings.Cardinality > facetMaxCardinality ||
+			value.Strings.Cardinality*2 > value.Count {
+			continue
+		}
+		facets = append(facets, key)
+		result.Suggestions = append(result.Suggestions, Suggestion{
+			Action:    Add,
+			Setting:   "attributesForFaceting",
+			Attribute: key,
+			Reason: fmt.Sprintf(
+				"%d distinct values in %.2f%% of the records",
+				value.Strings.Cardinality,
+				value.Percentage,
+			),
+		})
+	}
+	if len(facets) != len(settings.AttributesForFaceting) {
+		result.Patch["attributesForFaceting"] = facets
+	}
+
+	// Numeric attributes that aren't part of the custom ranking yet
+	customRanking := append([]string{}, settings.CustomRanking...)
+	for _, key := range keys {
+		value := stats.Attributes[key]
+		if value.Numeric == nil || utils.Contains(value.InSettings, "customRanking") {
+			continue
+		}
+		if value.Percentage < customRankingMinPercentage ||
+			value.Types[Numeric] < customRankingMinPercentage ||
+			value.Numeric.Min == value.Numeric.Max {
+			continue
+		}
+		customRanking = append(customRanking, fmt.Sprintf("desc(%s)", key))
+		result.Suggestions = append(result.Suggestions, Suggestion{
+			Action:    Add,
+			Setting:   "customRanking",
+			Attribute: key,
+			Reason: fmt.Sprintf(
+				"numeric in %.2f%% of the records, ranging from %v to %v (check the sort order)",
+				value.Types[Numeric],
+				value.Numeric.Min,
+				value.Numeric.Max,
+			),
+		})
+	}
+	if len(customRanking) != len(settings.CustomRanking) {
+		result.Patch["customRanking"] = customRanking
+	}
+
+	// Searchable attributes that are missing from most records
+	var sparse []string
+	for _, entry := range settings.SearchableAttributes {
+		for _, attribute := range settingAttributes(entry) {
+			value, ok := stats.Attributes[attribute]
+			if !ok || value.Percentage >= searchableMinPercentage {
+				continue
+			}
+			sparse = append(sparse, attribute)
+			result.Suggestions = append(result.Suggestions, Suggestion{
+				Action:    Remove,
+				Setting:   "searchableAttributes",
+				Attribute: attribute,
+				Reason:    fmt.Sprintf("only present in %.2f%% of the records", value.Percentage),
+			})
+		}
+	}
+	if len(sparse) > 0 {
+		result.Patch["searchableAttributes"] = removeAttributes(settings.SearchableAttributes, sparse)
+	}
+
+	// Configured attributes that don't exist in any record
+	for _, setting := range attributeSettings(settings) {
+		for _, entry := range setting.values {
+			for _, attribute := range settingAttributes(entry) {
+				if attribute == "*" || attributeExists(stats, attribute) {
+					continue
+				}
+				result.Suggestions = append(result.Suggestions, Suggestion{
+					Action:    Unknown,
+					Setting:   setting.name,
+					Attribute: attribute,
+					Reason:    "not present in any of the analyzed records",
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+type attributeSetting struct {
+	name   string
+	values []string
+}
+
+// attributeSettings returns the settings whose values are attribute names.
+func attributeSettings(s search.SettingsResponse) []attributeSetting {
+	settings := []attributeSetting{
+		{"searchableAttributes", s.SearchableAttributes},
+		{"attributesForFaceting", s.AttributesForFaceting},
+		{"customRanking", s.CustomRanking},
+		{"unretrievableAttributes", s.UnretrievableAttributes},
+		{"attributesToRetrieve", s.AttributesToRetrieve},
+		{"attributesToHighlight", s.AttributesToHighlight},
+		{"attributesToSnippet", s.AttributesToSnippet},
+		{"numericAttributesForFiltering", s.NumericAttributesForFiltering},
+		{"disableTypoToleranceOnAttributes", s.DisableTypoToleranceOnAttributes},
+		{"disablePrefixOnAttributes", s.DisablePrefixOnAttributes},
+		{"disableExactOnAttributes", s.DisableExactOnAttributes},
+		{"camelCaseAttributes", s.CamelCaseAttributes},
+		{"attributesToTransliterate", s.AttributesToTransliterate},
+	}
+	if s.AttributeForDistinct != nil {
+		settings = append(settings, attributeSetting{"attributeForDistinct", []string{*s.AttributeForDistinct}})
+	}
+	return settings
+}
+
+// settingAttributes returns the attribute names referenced by a setting value,
+// without modifiers (`unordered(title)`), snippet sizes (`content:20`),
+// and splitting attributes with the same priority (`title,alternative_title`).
+func settingAttributes(value string) []string {
+	for {
+		matches := attributeModifier.FindStringSubmatch(value)
+		if matches == nil {
+			break
+		}
+		value = matches[1]
+	}
+
+	var result []string
+	for _, attribute := range strings.Split(value, ",") {
+		attribute = strings.TrimSpace(attribute)
+		if i := strings.Index(attribute, ":"); i >= 0 {
+			attribute = attribute[:i]
+		}
+		if attribute != "" {
+			result = append(result, attribute)
+		}
+	}
+	return result
+}
+
+// attributeExists returns true if the attribute, or one of its nested attributes, is present in the stats.
+func attributeExists(stats *Stats, attribute string) bool {
+	if attribute == "objectID" {
+		return true
+	}
+	if _, ok := stats.Attributes[attribute]; ok {
+		return true
+	}
+	for key := range stats.Attributes {
+		if strings.HasPrefix(key, attribute+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// removeAttributes removes the given attributes from the values of a setting,
+// keeping the modifiers and the other attributes with the same priority.
+func removeAttributes(values []string, toRemove []string) []string {
+	result := []string{}
+	for _, value := range values {
+		// Unwrap the modifiers, to rebuild the value around the kept attributes
+		inner, prefix, suffix := value, "", ""
+		for {
+			matches := attributeModifier.FindStringSubmatch(inner)
+			if matches == nil {
+				break
+			}
+			prefix += inner[:len(inner)-len(matches[1])-1]
+			suffix += ")"
+			inner = matches[1]
+		}
+
+		var kept []string
+		removed := false
+		for _, part := range strings.Split(inner, ",") {
+			part = strings.TrimSpace(part)
+			attributes := settingAttributes(part)
+			if len(attributes) == 1 && utils.Contains(toRemove, attributes[0]) {
+				removed = true
+				continue
+			}
+			if part != "" {
+				kept = append(kept, part)
+			}
+		}
+		if !removed {
+			result = append(result, value)
+			continue
+		}
+		if len(kept) > 0 {
+			result = append(result, prefix+strings.Join(kept, ",")+suffix)
+		}
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package analyze
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Suggest(t *testing.T) {
+	stats := &Stats{
+		TotalRecords: 100,
+		Attributes: map[string]*AttributeStats{
+			"brand": {
+				Count:      100,
+				Percentage: 100,
+				Types:      map[AttributeType]float64{String: 100},
+				Strings:    &StringStats{Cardinality: 12},
+			},
+			"title": {
+				Count:      100,
+				Percentage: 100,
+				Types:      map[AttributeType]float64{String: 100},
+				InSettings: []string{"searchableAttributes"},
+				Strings:    &StringStats{Cardinality: 100},
+			},
+			"subtitle": {
+				Count:      20,
+				Percentage: 20,
+				Types:      map[AttributeType]float64{String: 20},
+				InSettings: []string{"searchableAttributes"},
+				Strings:    &StringStats{Cardinality: 20},
+			},
+			"popularity": {
+				Count:      100,
+				Percentage: 100,
+				Types:      map[AttributeType]float64{Numeric: 100},
+				Numeric:    &NumericStats{Min: 1, Max: 1000},
+			},
+			"price": {
+				Count:      100,
+				Percentage: 100,
+				Types:      map[AttributeType]float64{Numeric: 100},
+				InSettings: []string{"customRanking"},
+				Numeric:    &NumericStats{Min: 1, Max: 10},
+			},
+		},
+	}
+	settings := search.SettingsResponse{
+		SearchableAttributes: []string{"title,subtitle", "unordered(description)"},
+		CustomRanking:        []string{"asc(price)"},
+	}
+
+	suggestions := Suggest(stats, settings)
+
+	assert.Equal(t, []Suggestion{
+		{Action: Add, Setting: "attributesForFaceting", Attribute: "brand", Reason: "12 distinct values in 100.00% of the records"},
+		{Action: Add, Setting: "customRanking", Attribute: "popularity", Reason: "numeric in 100.00% of the records, ranging from 1 to 1000 (check the sort order)"},
+		{Action: Remove, Setting: "searchableAttributes", Attribute: "subtitle", Reason: "only present in 20.00% of the records"},
+		{Action: Unknown, Setting: "searchableAttributes", Attribute: "description", Reason: "not present in any of the analyzed records"},
+	}, suggestions.Suggestions)
+	assert.Equal(t, map[string]interface{}{
+		"attributesForFaceting": []string{"brand"},
+		"customRanking":         []string{"asc(price)", "desc(popularity)"},
+		"searchableAttributes":  []string{"title", "unordered(description)"},
+	}, suggestions.Patch)
+}
+
+func Test_settingAttributes(t *testing.T) {
+	scenarios := []struct {
+		Name   string
+		Input  string
+		Output []string
+	}{
+		{Name: "plain", Input: "title", Output: []string{"title"}},
+		{Name: "modifier", Input: "unordered(title)", Output: []string{"title"}},
+		{Name: "nested modifiers", Input: "afterDistinct(searchable(brand))", Output: []string{"brand"}},
+		{Name: "same priority", Input: "title, alternative_title", Output: []string{"title", "alternative_title"}},
+		{Name: "snippet size", Input: "content:20", Output: []string{"content"}},
+		{Name: "nested attribute", Input: "desc(meta.rating)", Output: []string{"meta.rating"}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			assert.Equal(t, s.Output, settingAttributes(s.Input))
+		})
+	}
+}
+
+func Test_removeAttributes(t *testing.T) {
+	scenarios := []struct {
+		Name   string
+		Input  []string
+		Output []string
+	}{
+		{Name: "single", Input: []string{"title", "subtitle"}, Output: []string{"title"}},
+		{Name: "same priority", Input: []string{"title,subtitle"}, Output: []string{"title"}},
+		{Name: "same priority with spaces", Input: []string{"title, subtitle, genre"}, Output: []string{"title,genre"}},
+		{Name: "modifier", Input: []string{"unordered(subtitle, title)"}, Output: []string{"unordered(title)"}},
+		{Name: "nested modifiers", Input: []string{"afterDistinct(searchable(subtitle))", "brand"}, Output: []string{"brand"}},
+		{Name: "snippet size", Input: []string{"content:20, subtitle:10"}, Output: []string{"content:20"}},
+		{Name: "untouched", Input: []string{"title , genre"}, Output: []string{"title , genre"}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			assert.Equal(t, s.Output, removeAttributes(s.Input, []string{"subtitle"}))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package analyze
+
+import (
+	"fmt"
+	"math"
+	"unicode/utf8"
+)
+
+// NumericStats contains the distribution of the values of a numeric attribute.
+type NumericStats struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+}
+
+// StringStats contains the length statistics and the cardinality of a string attribute.
+type StringStats struct {
+	MinLength   int     `json:"minLength"`
+	MaxLength   int     `json:"maxLength"`
+	MeanLength  float64 `json:"meanLength"`
+	Cardinality int     `json:"cardinality"`
+}
+
+// valueCollector collects the values of an attribute, with a bounded memory footprint.
+type valueCollector struct {
+	numericCount int
+	numericSum   float64
+	numericMin   float64
+	numericMax   float64
+	numbers      *reservoir
+
+	stringCount int
+	lengthSum   int
+	lengthMin   int
+	lengthMax   int
+	distinct    *cardinalityCounter
+
+	// frequencies is only set when analyzing a single attribute
+	frequencies *frequencies
+}
+
+// collectValues collects the numeric and string values of the given object.
+// Elements of arrays are collected as individual values.
+// When analyzing a single attribute, the frequency of each value is also collected.
+func collectValues(c map[string]*valueCollector, p string, o map[string]interface{}, only string) {
+	for key, value := range o {
+		if only != "" && only != key {
+			continue
+		}
+
+		var fullPath string
+		if p == "" {
+			fullPath = key
+		} else {
+			fullPath = fmt.Sprintf("%s.%s", p, key)
+		}
+
+		switch getType(value) {
+		case Object:
+			if v, ok := value.(map[string]interface{}); ok {
+				collectValues(c, fullPath, v, only)
+			}
+		case Array:
+			for _, v := range value.([]interface{}) {
+				collectValue(c, fullPath, v, only != "")
+			}
+		default:
+			collectValue(c, fullPath, value, only != "")
+		}
+	}
+}
+
+// collectValue adds a single scalar value to the collector of the given attribute.
+func collectValue(c map[string]*valueCollector, key string, value interface{}, withFrequencies bool) {
+	switch getType(value) {
+	case String, Numeric, Boolean:
+	default:
+		return
+	}
+
+	if _, ok := c[key]; !ok {
+		c[key] = &valueCollector{}
+		if withFrequencies {
+			c[key].frequencies = newFrequencies()
+		}
+	}
+	collector := c[key]
+
+	if collector.frequencies != nil {
+		collector.frequencies.add(value)
+	}
+
+	switch v := value.(type) {
+	case int:
+		collector.addNumber(float64(v))
+	case float64:
+		collector.addNumber(v)
+	case string:
+		collector.addString(v)
+	}
+}
+
+func (c *valueCollector) addNumber(n float64) {
+	if c.numbers == nil {
+		c.numbers = newReservoir()
+		c.numericMin = n
+		c.numericMax = n
+	}
+	c.numericCount++
+	c.numericSum += n
+	c.numericMin = math.Min(c.numericMin, n)
+	c.numericMax = math.Max(c.numericMax, n)
+	c.numbers.add(n)
+}
+
+func (c *valueCollector) addString(s string) {
+	l := utf8.RuneCountInString(s)
+	if c.distinct == nil {
+		c.distinct = newCardinalityCounter()
+		c.lengthMin = l
+		c.lengthMax = l
+	}
+	c.stringCount++
+	c.lengthSum += l
+	if l < c.lengthMin {
+		c.lengthMin = l
+	}
+	if l > c.lengthMax {
+		c.lengthMax = l
+	}
+	c.distinct.add(s)
+}
+
+// numericStats computes the numeric stats of the collected values.
+// It returns nil if no numeric value was collected.
+func (c *valueCollector) numericStats() *NumericStats {
+	if c.numericCount == 0 {
+		return nil
+	}
+
+	return &NumericStats{
+		Min:  c.numericMin,
+		Max:  c.numericMax,
+		Mean: c.numericSum / float64(c.numericCount),
+		P50:  c.numbers.percentile(50),
+		P95:  c.numbers.percentile(95),
+	}
+}
+
+// stringStats computes the string stats of the collected values.
+// It returns nil if no string value was collected.
+func (c *valueCollector) stringStats() *StringStats {
+	if c.stringCount == 0 {
+		return nil
+	}
+
+	return &StringStats{
+		MinLength:   c.lengthMin,
+		MaxLength:   c.lengthMax,
+		MeanLength:  float64(c.lengthSum) / float64(c.stringCount),
+		Cardinality: c.distinct.count(),
+	}
+}
+
+// percentileIndex returns the index of the p-th percentile in a sorted slice of length n,
+// using the nearest-rank method.
+func percentileIndex(n int, p float64) int {
+	i := int(math.Ceil(p/100*float64(n))) - 1
+	if i < 0 {
+		return 0
+	}
+	if i >= n {
+		return n - 1
+	}
+	return i
+}
@@ -0,0 +1,53 @@
+package analyze
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_collectValues(t *testing.T) {
+	collectors := make(map[string]*valueCollector)
+	records := []map[string]interface{}{
+		{"price": 10.0, "brand": "acme", "tags": []interface{}{"a", "bb"}},
+		{"price": 20, "brand": "acme", "meta": map[string]interface{}{"rating": 4.5}},
+		{"price": 30.0, "brand": "globex", "tags": []interface{}{"ccc"}},
+		{"price": 40.0, "brand": nil},
+	}
+	for _, r := range records {
+		collectValues(collectors, "", r, "")
+	}
+
+	assert.Equal(t, &NumericStats{Min: 10, Max: 40, Mean: 25, P50: 20, P95: 40}, collectors["price"].numericStats())
+	assert.Nil(t, collectors["price"].stringStats())
+
+	assert.Equal(t, &StringStats{MinLength: 4, MaxLength: 6, MeanLength: 14.0 / 3, Cardinality: 2}, collectors["brand"].stringStats())
+	assert.Nil(t, collectors["brand"].numericStats())
+
+	assert.Equal(t, &StringStats{MinLength: 1, MaxLength: 3, MeanLength: 2, Cardinality: 3}, collectors["tags"].stringStats())
+
+	assert.Equal(t, &NumericStats{Min: 4.5, Max: 4.5, Mean: 4.5, P50: 4.5, P95: 4.5}, collectors["meta.rating"].numericStats())
+	assert.NotContains(t, collectors, "meta")
+}
+
+func Test_percentileIndex(t *testing.T) {
+	scenarios := []struct {
+		Name       string
+		N          int
+		Percentile float64
+		Output     int
+	}{
+		{Name: "single value", N: 1, Percentile: 50, Output: 0},
+		{Name: "median of even length", N: 4, Percentile: 50, Output: 1},
+		{Name: "median of odd length", N: 5, Percentile: 50, Output: 2},
+		{Name: "p95", N: 100, Percentile: 95, Output: 94},
+		{Name: "p0", N: 10, Percentile: 0, Output: 0},
+		{Name: "p100", N: 10, Percentile: 100, Output: 9},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			assert.Equal(t, s.Output, percentileIndex(s.N, s.Percentile))
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Package bench replays search queries and measures their latency.
+package bench
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SearchFunc runs a search and returns the processing time reported by the server.
+type SearchFunc func(query string) (processingTime time.Duration, err error)
+
+// Options are the options of a benchmark.
+type Options struct {
+	// Concurrency is the number of searches at the same time.
+	Concurrency int
+	// Duration is the time after which no new search starts.
+	Duration time.Duration
+	// Requests is the maximum number of searches, or 0 for no limit.
+	Requests int
+}
+
+// Sample is the measure of a search.
+type Sample struct {
+	// Latency is the time between sending the request and reading the response.
+	Latency time.Duration
+	// ProcessingTime is the time spent by the server, from processingTimeMS.
+	ProcessingTime time.Duration
+	Err            error
+}
+
+// Run replays the queries in a loop, until the duration or the number of requests is reached.
+// It returns the samples and the time it took.
+func Run(opts Options, queries []string, search SearchFunc) ([]Sample, time.Duration) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		next    atomic.Int64
+		samples []Sample
+	)
+
+	start := time.Now()
+	deadline := start.Add(opts.Duration)
+	for w := 0; w < opts.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				i := int(next.Add(1) - 1)
+				if opts.Requests > 0 && i >= opts.Requests {
+					return
+				}
+				requestStart := time.Now()
+				processingTime, err := search(queries[i%len(queries)])
+				sample := Sample{Latency: time.Since(requestStart), ProcessingTime: processingTime, Err: err}
+
+				mu.Lock()
+				samples = append(samples, sample)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return samples, time.Since(start)
+}
+
+// Stats are the statistics of durations, in milliseconds.
+type Stats struct {
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Mean float64 `json:"mean"`
+	Max  float64 `json:"max"`
+}
+
+// Report is the summary of a benchmark.
+type Report struct {
+	Requests          int     `json:"requests"`
+	Errors            int     `json:"errors"`
+	ErrorRate         float64 `json:"errorRate"`
+	DurationSeconds   float64 `json:"durationSeconds"`
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// Latency is the time of the requests, seen by the client.
+	Latency Stats `json:"latencyMS"`
+	// ProcessingTime is the time spent by the server.
+	ProcessingTime Stats `json:"processingTimeMS"`
+	// Network is the latency without the processing time: network, TLS and queueing.
+	Network Stats `json:"networkMS"`
+	// ErrorMessages counts the errors by message.
+	ErrorMessages map[string]int `json:"errorMessages,omitempty"`
+}
+
+// Summarize computes the report of the samples. Only successful requests count in the statistics.
+func Summarize(samples []Sample, elapsed time.Duration) Report {
+	report := Report{
+		Requests:        len(samples),
+		DurationSeconds: round(elapsed.Seconds()),
+	}
+
+	var latencies, processingTimes, networkTimes []time.Duration
+	for _, sample := range samples {
+		if sample.Err != nil {
+			report.Errors++
+			if report.ErrorMessages == nil {
+				report.ErrorMessages = map[string]int{}
+			}
+			report.ErrorMessages[sample.Err.Error()]++
+			continue
+		}
+		latencies = append(latencies, sample.Latency)
+		processingTimes = append(processingTimes, sample.ProcessingTime)
+		network := sample.Latency - sample.ProcessingTime
+		if network < 0 {
+			network = 0
+		}
+		networkTimes = append(networkTimes, network)
+	}
+
+	if report.Requests > 0 {
+		report.ErrorRate = round(float64(report.Errors) / float64(report.Requests))
+	}
+	if elapsed > 0 {
+		report.RequestsPerSecond = round(float64(report.Requests) / elapsed.Seconds())
+	}
+	report.Latency = computeStats(latencies)
+	report.ProcessingTime = computeStats(processingTimes)
+	report.Network = computeStats(networkTimes)
+
+	return report
+}
+
+func computeStats(durations []time.Duration) Stats {
+	if len(durations) == 0 {
+		return Stats{}
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	return Stats{
+		P50:  milliseconds(Percentile(durations, 50)),
+		P90:  milliseconds(Percentile(durations, 90)),
+		P99:  milliseconds(Percentile(durations, 99)),
+		Mean: milliseconds(total / time.Duration(len(durations))),
+		Max:  milliseconds(durations[len(durations)-1]),
+	}
+}
+
+// Percentile returns the p-th percentile of sorted durations, with the nearest-rank method.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return round(float64(d) / float64(time.Millisecond))
+}
+
+// round rounds to 3 decimals, to keep the JSON output readable.
+func round(f float64) float64 {
+	return math.Round(f*1000) / 1000
+}
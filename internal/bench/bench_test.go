@@ -0,0 +1,84 @@
+package bench
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	samples, _ := Run(
+		Options{Concurrency: 3, Duration: time.Minute, Requests: 7},
+		[]string{"a", "b"},
+		func(query string) (time.Duration, error) {
+			mu.Lock()
+			queries = append(queries, query)
+			mu.Unlock()
+			if query == "b" {
+				return 0, errors.New("timeout")
+			}
+			return time.Millisecond, nil
+		},
+	)
+
+	assert.Len(t, samples, 7)
+	assert.ElementsMatch(t, []string{"a", "b", "a", "b", "a", "b", "a"}, queries)
+}
+
+func TestRun_duration(t *testing.T) {
+	samples, elapsed := Run(
+		Options{Concurrency: 2, Duration: 20 * time.Millisecond},
+		[]string{"a"},
+		func(string) (time.Duration, error) {
+			time.Sleep(5 * time.Millisecond)
+			return 0, nil
+		},
+	)
+
+	assert.NotEmpty(t, samples)
+	assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+}
+
+func TestSummarize(t *testing.T) {
+	var samples []Sample
+	for i := 1; i <= 10; i++ {
+		samples = append(samples, Sample{
+			Latency:        time.Duration(i*10) * time.Millisecond,
+			ProcessingTime: time.Duration(i) * time.Millisecond,
+		})
+	}
+	samples = append(samples,
+		Sample{Latency: time.Second, Err: errors.New("timeout")},
+		Sample{Latency: time.Second, Err: errors.New("timeout")},
+	)
+
+	report := Summarize(samples, 4*time.Second)
+
+	assert.Equal(t, Report{
+		Requests:          12,
+		Errors:            2,
+		ErrorRate:         0.167,
+		DurationSeconds:   4,
+		RequestsPerSecond: 3,
+		Latency:           Stats{P50: 50, P90: 90, P99: 100, Mean: 55, Max: 100},
+		ProcessingTime:    Stats{P50: 5, P90: 9, P99: 10, Mean: 5.5, Max: 10},
+		Network:           Stats{P50: 45, P90: 81, P99: 90, Mean: 49.5, Max: 90},
+		ErrorMessages:     map[string]int{"timeout": 2},
+	}, report)
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4}
+
+	assert.Equal(t, time.Duration(1), Percentile(sorted, 0))
+	assert.Equal(t, time.Duration(2), Percentile(sorted, 50))
+	assert.Equal(t, time.Duration(4), Percentile(sorted, 99))
+	assert.Equal(t, time.Duration(0), Percentile(nil, 50))
+}
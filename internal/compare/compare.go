@@ -0,0 +1,158 @@
+// Package compare compares the rankings of the results of two searches.
+package compare
+
+import (
+	"math"
+	"sort"
+)
+
+// RankChange is the ranks of a record in the results of both searches, starting at 1.
+// A rank of 0 means that the record isn't in the compared hits.
+type RankChange struct {
+	ObjectID string `json:"objectID"`
+	RankA    int    `json:"rankA"`
+	RankB    int    `json:"rankB"`
+}
+
+// Result is the comparison of the results of a query.
+type Result struct {
+	Query   string `json:"query"`
+	NbHitsA int    `json:"nbHitsA"`
+	NbHitsB int    `json:"nbHitsB"`
+	// Overlap is the share of the first k hits that are in both results.
+	Overlap float64 `json:"overlap"`
+	// NDCG is the similarity of the rankings, from 0 (nothing in common) to 1 (same ranking).
+	NDCG float64 `json:"ndcg"`
+	// Changes are the records whose rank changed.
+	Changes []RankChange `json:"changes,omitempty"`
+}
+
+// Changed returns true if the first k hits aren't the same, in the same order.
+func (r Result) Changed() bool {
+	return len(r.Changes) > 0
+}
+
+// Compare compares the first k objectIDs of the results of both searches.
+func Compare(query string, a, b []string, k int) Result {
+	a, b = top(a, k), top(b, k)
+	return Result{
+		Query:   query,
+		Overlap: round(Overlap(a, b, k)),
+		NDCG:    round(NDCG(a, b, k)),
+		Changes: rankChanges(a, b),
+	}
+}
+
+// Overlap returns the share of the first k hits that are in both results.
+func Overlap(a, b []string, k int) float64 {
+	a, b = top(a, k), top(b, k)
+	size := max(len(a), len(b))
+	if size == 0 {
+		return 1
+	}
+	inA := ranks(a)
+	common := 0
+	for _, id := range b {
+		if _, ok := inA[id]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(size)
+}
+
+// NDCG returns the normalized discounted cumulative gain of the first k hits of b,
+// with the ranking of a as the ideal ranking.
+// The first hit of a has a relevance of k, the second one k-1, and so on.
+func NDCG(a, b []string, k int) float64 {
+	a, b = top(a, k), top(b, k)
+	relevance := func(id string, inA map[string]int) float64 {
+		rank, ok := inA[id]
+		if !ok {
+			return 0
+		}
+		return float64(k - rank + 1)
+	}
+	inA := ranks(a)
+	dcg := func(hits []string) float64 {
+		var sum float64
+		for i, id := range hits {
+			sum += relevance(id, inA) / math.Log2(float64(i+2))
+		}
+		return sum
+	}
+
+	ideal := dcg(a)
+	if ideal == 0 {
+		if len(b) == 0 {
+			return 1
+		}
+		return 0
+	}
+	return dcg(b) / ideal
+}
+
+// rankChanges returns the records whose rank changed,
+// in the order of b, then the records that are only in a.
+func rankChanges(a, b []string) []RankChange {
+	inA, inB := ranks(a), ranks(b)
+	var changes []RankChange
+	for i, id := range b {
+		if inA[id] != i+1 {
+			changes = append(changes, RankChange{ObjectID: id, RankA: inA[id], RankB: i + 1})
+		}
+	}
+	for i, id := range a {
+		if _, ok := inB[id]; !ok {
+			changes = append(changes, RankChange{ObjectID: id, RankA: i + 1})
+		}
+	}
+	return changes
+}
+
+// SortByChange sorts the results from the most to the least changed:
+// by increasing NDCG, then by increasing overlap.
+func SortByChange(results []Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.NDCG != b.NDCG {
+			return a.NDCG < b.NDCG
+		}
+		return a.Overlap < b.Overlap
+	})
+}
+
+// Mean returns the mean overlap and the mean NDCG of the results.
+func Mean(results []Result) (overlap float64, ndcg float64) {
+	if len(results) == 0 {
+		return 1, 1
+	}
+	for _, r := range results {
+		overlap += r.Overlap
+		ndcg += r.NDCG
+	}
+	n := float64(len(results))
+	return round(overlap / n), round(ndcg / n)
+}
+
+func top(hits []string, k int) []string {
+	if len(hits) > k {
+		return hits[:k]
+	}
+	return hits
+}
+
+// ranks returns the rank of each objectID, starting at 1.
+func ranks(hits []string) map[string]int {
+	r := make(map[string]int, len(hits))
+	for i, id := range hits {
+		if _, ok := r[id]; !ok {
+			r[id] = i + 1
+		}
+	}
+	return r
+}
+
+// round rounds to 3 decimals, to keep the output readable.
+func round(f float64) float64 {
+	return math.Round(f*1000) / 1000
+}
@@ -0,0 +1,87 @@
+package compare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want Result
+	}{
+		{
+			name: "same ranking",
+			a:    []string{"1", "2", "3"},
+			b:    []string{"1", "2", "3", "4"},
+			want: Result{Query: "q", Overlap: 1, NDCG: 1},
+		},
+		{
+			name: "swapped",
+			a:    []string{"1", "2", "3"},
+			b:    []string{"2", "1", "3"},
+			want: Result{
+				Query:   "q",
+				Overlap: 1,
+				NDCG:    0.922,
+				Changes: []RankChange{{ObjectID: "2", RankA: 2, RankB: 1}, {ObjectID: "1", RankA: 1, RankB: 2}},
+			},
+		},
+		{
+			name: "new and removed",
+			a:    []string{"1", "2", "3"},
+			b:    []string{"1", "4", "2"},
+			want: Result{
+				Query:   "q",
+				Overlap: 0.667,
+				NDCG:    0.84,
+				Changes: []RankChange{
+					{ObjectID: "4", RankB: 2},
+					{ObjectID: "2", RankA: 2, RankB: 3},
+					{ObjectID: "3", RankA: 3},
+				},
+			},
+		},
+		{
+			name: "nothing in common",
+			a:    []string{"1"},
+			b:    []string{"2"},
+			want: Result{
+				Query:   "q",
+				Overlap: 0,
+				NDCG:    0,
+				Changes: []RankChange{{ObjectID: "2", RankB: 1}, {ObjectID: "1", RankA: 1}},
+			},
+		},
+		{
+			name: "no results",
+			want: Result{Query: "q", Overlap: 1, NDCG: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Compare("q", tt.a, tt.b, 3))
+		})
+	}
+}
+
+func TestSortByChange(t *testing.T) {
+	results := []Result{
+		{Query: "same", Overlap: 1, NDCG: 1},
+		{Query: "reordered", Overlap: 1, NDCG: 0.9},
+		{Query: "different", Overlap: 0.5, NDCG: 0.9},
+	}
+
+	SortByChange(results)
+	assert.Equal(t, "different", results[0].Query)
+	assert.Equal(t, "reordered", results[1].Query)
+	assert.Equal(t, "same", results[2].Query)
+
+	overlap, ndcg := Mean(results)
+	assert.Equal(t, 0.833, overlap)
+	assert.Equal(t, 0.933, ndcg)
+}
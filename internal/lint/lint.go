@@ -0,0 +1,124 @@
+// Package lint finds problems in the configuration of an index, like expired or redundant rules.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// Severity is the importance of a lint issue.
+type Severity string
+
+const (
+	Info    Severity = "info"
+	Warning Severity = "warning"
+	Error   Severity = "error"
+)
+
+// severityLevels orders the severities, from the least to the most important.
+var severityLevels = map[Severity]int{
+	Info:    0,
+	Warning: 1,
+	Error:   2,
+}
+
+// ParseSeverity returns the severity with the given name.
+func ParseSeverity(s string) (Severity, bool) {
+	_, ok := severityLevels[Severity(s)]
+	return Severity(s), ok
+}
+
+// AtLeast returns true if the severity is at least as important as the other one.
+func (s Severity) AtLeast(other Severity) bool {
+	return severityLevels[s] >= severityLevels[other]
+}
+
+// Issue is a problem found by a lint check.
+type Issue struct {
+	Severity Severity `json:"severity"`
+	// ObjectID is the ID of the rule or synonym with the issue.
+	// It's empty for issues about the index itself.
+	ObjectID string `json:"objectID,omitempty"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+}
+
+// HasIssues returns true if one of the issues is at least as important as the given severity.
+func HasIssues(issues []Issue, severity Severity) bool {
+	for _, issue := range issues {
+		if issue.Severity.AtLeast(severity) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortIssues sorts issues by decreasing severity, then by objectID and check.
+func sortIssues(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Severity != b.Severity {
+			return severityLevels[a.Severity] > severityLevels[b.Severity]
+		}
+		if a.ObjectID != b.ObjectID {
+			return a.ObjectID < b.ObjectID
+		}
+		return a.Check < b.Check
+	})
+}
+
+// PrintIssues prints the issues in a table format, or a success message if there are none.
+// The noun is what was linted, like "rule", and count is how many of them were linted.
+func PrintIssues(io *iostreams.IOStreams, issues []Issue, count int, noun string) error {
+	cs := io.ColorScheme()
+
+	if len(issues) == 0 {
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.Out, "%s No issues found in %s\n", cs.SuccessIcon(), utils.Pluralize(count, noun))
+		}
+		return nil
+	}
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("SEVERITY", nil, nil)
+		table.AddField(strings.ToUpper(noun), nil, nil)
+		table.AddField("CHECK", nil, nil)
+		table.AddField("MESSAGE", nil, nil)
+		table.EndRow()
+	}
+	for _, issue := range issues {
+		severity := string(issue.Severity)
+		switch issue.Severity {
+		case Error:
+			severity = cs.Red(severity)
+		case Warning:
+			severity = cs.Yellow(severity)
+		case Info:
+			severity = cs.Gray(severity)
+		}
+		table.AddField(severity, nil, nil)
+		table.AddField(issue.ObjectID, nil, nil)
+		table.AddField(issue.Check, nil, nil)
+		table.AddField(issue.Message, nil, nil)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(
+			io.Out,
+			"\nFound %s in %s\n",
+			utils.Pluralize(len(issues), "issue"),
+			utils.Pluralize(count, noun),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+func TestPrintIssues(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	issues := []Issue{
+		{Severity: Warning, ObjectID: "1", Check: "duplicate", Message: "same as 2"},
+	}
+	require.NoError(t, PrintIssues(io, issues, 2, "synonym"))
+	assert.Contains(t, stdout.String(), "SYNONYM")
+	assert.Contains(t, stdout.String(), "same as 2")
+	assert.Contains(t, stdout.String(), "Found 1 issue in 2 synonyms")
+
+	stdout.Reset()
+	require.NoError(t, PrintIssues(io, nil, 3, "rule"))
+	assert.Contains(t, stdout.String(), "No issues found in 3 rules")
+}
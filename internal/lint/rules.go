@@ -0,0 +1,344 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// Names of the rule checks
+const (
+	CheckExpired           = "expired"
+	CheckDuplicate         = "duplicate-conditions"
+	CheckMissingPromotion  = "missing-promoted-object"
+	CheckUnknownContext    = "unknown-context"
+	CheckUnusedContext     = "unused-context"
+	CheckUnknownAttributes = "unknown-filter-attribute"
+)
+
+// RulesOptions are the data the rules are checked against.
+// Checks that need data that isn't available (nil) are skipped.
+type RulesOptions struct {
+	// Now is the time to compare the validity of the rules with.
+	Now time.Time
+	// ObjectIDs are the IDs of the records of the index.
+	ObjectIDs map[string]bool
+	// Contexts are the rule contexts sent by the search requests of the application.
+	Contexts []string
+	// FacetAttributes are the attributes that can be used in facet filters (`attributesForFaceting`).
+	FacetAttributes []string
+	// NumericAttributes are the attributes that can be used in numeric filters (`numericAttributesForFiltering`).
+	// If empty, all the attributes can.
+	NumericAttributes []string
+}
+
+// Rules checks a set of rules and returns the issues found, from the most to the least important.
+func Rules(rules []search.Rule, opts RulesOptions) []Issue {
+	issues := []Issue{}
+	issues = append(issues, expiredRules(rules, opts.Now)...)
+	issues = append(issues, duplicateConditions(rules)...)
+	if opts.ObjectIDs != nil {
+		issues = append(issues, missingPromotions(rules, opts.ObjectIDs)...)
+	}
+	if opts.Contexts != nil {
+		issues = append(issues, contexts(rules, opts.Contexts)...)
+	}
+	if opts.FacetAttributes != nil {
+		issues = append(issues, unknownFilterAttributes(rules, opts.FacetAttributes, opts.NumericAttributes)...)
+	}
+	sortIssues(issues)
+	return issues
+}
+
+// expiredRules reports the rules whose validity windows have all passed.
+func expiredRules(rules []search.Rule, now time.Time) []Issue {
+	var issues []Issue
+	for _, rule := range rules {
+		if len(rule.Validity) == 0 {
+			continue
+		}
+		var last int64
+		for _, v := range rule.Validity {
+			last = max(last, v.Until)
+		}
+		if last < now.Unix() {
+			issues = append(issues, Issue{
+				Severity: Warning,
+				ObjectID: rule.ObjectID,
+				Check:    CheckExpired,
+				Message: fmt.Sprintf(
+					"all the validity windows have passed, the last one ended on %s",
+					time.Unix(last, 0).UTC().Format(time.RFC3339),
+				),
+			})
+		}
+	}
+	return issues
+}
+
+// duplicateConditions reports the rules with identical conditions that can be active at the same time.
+func duplicateConditions(rules []search.Rule) []Issue {
+	groups := map[string][]search.Rule{}
+	var keys []string
+	for _, rule := range rules {
+		if len(rule.Conditions) == 0 {
+			continue
+		}
+		conditions := make([]string, 0, len(rule.Conditions))
+		for _, c := range rule.Conditions {
+			b, err := json.Marshal(c)
+			if err != nil {
+				continue
+			}
+			conditions = append(conditions, string(b))
+		}
+		sort.Strings(conditions)
+		key := strings.Join(conditions, ",")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], rule)
+	}
+
+	var issues []Issue
+	for _, key := range keys {
+		group := groups[key]
+		for i, rule := range group {
+			var conflicts []string
+			for j, other := range group {
+				if i != j && overlap(rule.Validity, other.Validity) {
+					conflicts = append(conflicts, other.ObjectID)
+				}
+			}
+			if len(conflicts) > 0 {
+				issues = append(issues, Issue{
+					Severity: Error,
+					ObjectID: rule.ObjectID,
+					Check:    CheckDuplicate,
+					Message:  fmt.Sprintf("same conditions as %s", strings.Join(conflicts, ", ")),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// overlap returns true if two rules can be active at the same time.
+// A rule without validity is always active.
+func overlap(a, b []search.TimeRange) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, x := range a {
+		for _, y := range b {
+			if x.From < y.Until && y.From < x.Until {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// missingPromotions reports the promotions of records that don't exist in the index.
+func missingPromotions(rules []search.Rule, objectIDs map[string]bool) []Issue {
+	var issues []Issue
+	for _, rule := range rules {
+		var missing []string
+		for _, p := range rule.Consequence.Promote {
+			var ids []string
+			if p.PromoteObjectID != nil {
+				ids = append(ids, p.PromoteObjectID.ObjectID)
+			}
+			if p.PromoteObjectIDs != nil {
+				ids = append(ids, p.PromoteObjectIDs.ObjectIDs...)
+			}
+			for _, id := range ids {
+				if !objectIDs[id] {
+					missing = append(missing, id)
+				}
+			}
+		}
+		if len(missing) > 0 {
+			issues = append(issues, Issue{
+				Severity: Error,
+				ObjectID: rule.ObjectID,
+				Check:    CheckMissingPromotion,
+				Message:  fmt.Sprintf("promoted records don't exist: %s", strings.Join(missing, ", ")),
+			})
+		}
+	}
+	return issues
+}
+
+// contexts reports the rules with a context that the application never sends,
+// and the contexts of the application that no rule uses.
+func contexts(rules []search.Rule, known []string) []Issue {
+	var issues []Issue
+	used := map[string]bool{}
+	for _, rule := range rules {
+		for _, c := range rule.Conditions {
+			if c.Context == nil || *c.Context == "" {
+				continue
+			}
+			used[*c.Context] = true
+			if !utils.Contains(known, *c.Context) {
+				issues = append(issues, Issue{
+					Severity: Warning,
+					ObjectID: rule.ObjectID,
+					Check:    CheckUnknownContext,
+					Message:  fmt.Sprintf("the context %q isn't one of the contexts of the application", *c.Context),
+				})
+			}
+		}
+	}
+	for _, c := range known {
+		if !used[c] {
+			issues = append(issues, Issue{
+				Severity: Info,
+				Check:    CheckUnusedContext,
+				Message:  fmt.Sprintf("no rule uses the context %q", c),
+			})
+		}
+	}
+	return issues
+}
+
+// unknownFilterAttributes reports the rules with filters on attributes that can't be filtered on.
+func unknownFilterAttributes(rules []search.Rule, facets []string, numerics []string) []Issue {
+	var issues []Issue
+	for _, rule := range rules {
+		var filters []string
+		for _, c := range rule.Conditions {
+			if c.Filters != nil {
+				filters = append(filters, *c.Filters)
+			}
+		}
+		filters = append(filters, paramsFilters(rule.Consequence.Params)...)
+
+		var unknown []string
+		for _, f := range filters {
+			for _, attr := range filterAttributes(f) {
+				if attr.name == "_tags" || utils.Contains(facets, attr.name) {
+					continue
+				}
+				if attr.numeric && (len(numerics) == 0 || utils.Contains(numerics, attr.name)) {
+					continue
+				}
+				if !utils.Contains(unknown, attr.name) {
+					unknown = append(unknown, attr.name)
+				}
+			}
+		}
+		if len(unknown) > 0 {
+			issues = append(issues, Issue{
+				Severity: Warning,
+				ObjectID: rule.ObjectID,
+				Check:    CheckUnknownAttributes,
+				Message: fmt.Sprintf(
+					"unknown attributes in filters: %s",
+					strings.Join(unknown, ", "),
+				),
+			})
+		}
+	}
+	return issues
+}
+
+// paramsFilters returns the filters of the search parameters of a rule consequence,
+// as filter expressions like `brand:Apple`.
+func paramsFilters(params *search.ConsequenceParams) []string {
+	if params == nil {
+		return nil
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+
+	var filters []string
+	if f, ok := m["filters"].(string); ok {
+		filters = append(filters, f)
+	}
+	for _, key := range []string{"facetFilters", "optionalFilters", "numericFilters"} {
+		filters = append(filters, flattenFilters(m[key])...)
+	}
+	return filters
+}
+
+// flattenFilters flattens nested arrays of filters, like [["a:1", "a:2"], "b:3"].
+func flattenFilters(v interface{}) []string {
+	switch f := v.(type) {
+	case string:
+		// Negative facet filters, like "-brand:Apple"
+		return []string{strings.TrimPrefix(f, "-")}
+	case []interface{}:
+		var filters []string
+		for _, e := range f {
+			filters = append(filters, flattenFilters(e)...)
+		}
+		return filters
+	}
+	return nil
+}
+
+type filterAttribute struct {
+	name    string
+	numeric bool
+}
+
+// filterTokenRegexp matches the tokens of a filter expression: quoted strings or words,
+// followed by an operator if the token is an attribute.
+var filterTokenRegexp = regexp.MustCompile(`("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|[^\s()"'<>=!:]+)\s*(:|<=|>=|!=|<|>|=)?`)
+
+// filterAttributes returns the attributes used in a filter expression.
+// Comparisons (`price > 10`) and numeric ranges (`price:10 TO 20`) are numeric filters.
+func filterAttributes(filter string) []filterAttribute {
+	matches := filterTokenRegexp.FindAllStringSubmatch(filter, -1)
+
+	var attributes []filterAttribute
+	for i, m := range matches {
+		op := m[2]
+		if op == "" {
+			continue
+		}
+		name := m[1]
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			name = unquoted
+		} else {
+			name = strings.Trim(name, `'"`)
+		}
+
+		numeric := op != ":"
+		if !numeric && i+1 < len(matches) {
+			_, err := strconv.ParseFloat(matches[i+1][1], 64)
+			numeric = err == nil
+		}
+		attributes = append(attributes, filterAttribute{name: name, numeric: numeric})
+	}
+	return attributes
+}
+
+var modifierRegexp = regexp.MustCompile(`^\w+\((.*)\)$`)
+
+// AttributeName removes the modifiers of an attribute in the settings, like `searchable(brand)`.
+func AttributeName(attribute string) string {
+	for {
+		m := modifierRegexp.FindStringSubmatch(attribute)
+		if m == nil {
+			return attribute
+		}
+		attribute = m[1]
+	}
+}
@@ -0,0 +1,153 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseRules(t *testing.T, rules ...string) []search.Rule {
+	t.Helper()
+	var res []search.Rule
+	for _, r := range rules {
+		var rule search.Rule
+		require.NoError(t, json.Unmarshal([]byte(r), &rule))
+		res = append(res, rule)
+	}
+	return res
+}
+
+func TestRules(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rules []string
+		opts  RulesOptions
+		want  []Issue
+	}{
+		{
+			name: "expired rules",
+			rules: []string{
+				`{"objectID":"expired","consequence":{},"validity":[{"from":1000,"until":2000},{"from":3000,"until":1704067200}]}`,
+				`{"objectID":"active","consequence":{},"validity":[{"from":1000,"until":2000},{"from":3000,"until":1767225600}]}`,
+				`{"objectID":"always","consequence":{}}`,
+			},
+			want: []Issue{
+				{
+					Severity: Warning,
+					ObjectID: "expired",
+					Check:    CheckExpired,
+					Message:  "all the validity windows have passed, the last one ended on 2024-01-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name: "duplicate conditions",
+			rules: []string{
+				`{"objectID":"a","conditions":[{"pattern":"sale","anchoring":"is"}],"consequence":{}}`,
+				`{"objectID":"b","conditions":[{"anchoring":"is","pattern":"sale"}],"consequence":{}}`,
+				`{"objectID":"c","conditions":[{"pattern":"sale","anchoring":"contains"}],"consequence":{}}`,
+				`{"objectID":"winter","conditions":[{"context":"home"}],"consequence":{},"validity":[{"from":1767225600,"until":1767312000}]}`,
+				`{"objectID":"summer","conditions":[{"context":"home"}],"consequence":{},"validity":[{"from":1767312000,"until":1767398400}]}`,
+			},
+			want: []Issue{
+				{Severity: Error, ObjectID: "a", Check: CheckDuplicate, Message: "same conditions as b"},
+				{Severity: Error, ObjectID: "b", Check: CheckDuplicate, Message: "same conditions as a"},
+			},
+		},
+		{
+			name: "missing promoted records",
+			rules: []string{
+				`{"objectID":"a","consequence":{"promote":[{"objectID":"1","position":0},{"objectIDs":["2","3"],"position":1}]}}`,
+			},
+			opts: RulesOptions{ObjectIDs: map[string]bool{"1": true, "3": true}},
+			want: []Issue{
+				{Severity: Error, ObjectID: "a", Check: CheckMissingPromotion, Message: "promoted records don't exist: 2"},
+			},
+		},
+		{
+			name: "contexts",
+			rules: []string{
+				`{"objectID":"a","conditions":[{"context":"mobile"}],"consequence":{}}`,
+				`{"objectID":"b","conditions":[{"context":"tv"}],"consequence":{}}`,
+			},
+			opts: RulesOptions{Contexts: []string{"mobile", "desktop"}},
+			want: []Issue{
+				{
+					Severity: Warning,
+					ObjectID: "b",
+					Check:    CheckUnknownContext,
+					Message:  `the context "tv" isn't one of the contexts of the application`,
+				},
+				{Severity: Info, Check: CheckUnusedContext, Message: `no rule uses the context "desktop"`},
+			},
+		},
+		{
+			name: "unknown filter attributes",
+			rules: []string{
+				`{"objectID":"a","conditions":[{"filters":"brand:Apple"}],"consequence":{"params":{"filters":"(color:\"red:dark\" OR colour:blue) AND NOT _tags:sale AND price > 10"}}}`,
+				`{"objectID":"b","consequence":{"params":{"facetFilters":[["brand:Apple","-size:XL"],"category:phones"],"numericFilters":["stock>0"]}}}`,
+				`{"objectID":"c","consequence":{"params":{"filters":"year:2000 TO 2010 AND \"release date\":2020"}}}`,
+			},
+			opts: RulesOptions{FacetAttributes: []string{"brand", "color", "category"}},
+			want: []Issue{
+				{
+					Severity: Warning,
+					ObjectID: "a",
+					Check:    CheckUnknownAttributes,
+					Message:  "unknown attributes in filters: colour",
+				},
+				{
+					Severity: Warning,
+					ObjectID: "b",
+					Check:    CheckUnknownAttributes,
+					Message:  "unknown attributes in filters: size",
+				},
+			},
+		},
+		{
+			name: "unknown numeric filter attributes",
+			rules: []string{
+				`{"objectID":"a","consequence":{"params":{"filters":"price > 10 AND year:2000 TO 2010"}}}`,
+			},
+			opts: RulesOptions{FacetAttributes: []string{}, NumericAttributes: []string{"price"}},
+			want: []Issue{
+				{
+					Severity: Warning,
+					ObjectID: "a",
+					Check:    CheckUnknownAttributes,
+					Message:  "unknown attributes in filters: year",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Now = now
+			want := tt.want
+			if want == nil {
+				want = []Issue{}
+			}
+			assert.Equal(t, want, Rules(parseRules(t, tt.rules...), tt.opts))
+		})
+	}
+}
+
+func TestAttributeName(t *testing.T) {
+	assert.Equal(t, "brand", AttributeName("brand"))
+	assert.Equal(t, "brand", AttributeName("searchable(brand)"))
+	assert.Equal(t, "color", AttributeName("afterDistinct(filterOnly(color))"))
+}
+
+func TestHasIssues(t *testing.T) {
+	issues := []Issue{{Severity: Warning}}
+	assert.True(t, HasIssues(issues, Info))
+	assert.True(t, HasIssues(issues, Warning))
+	assert.False(t, HasIssues(issues, Error))
+}
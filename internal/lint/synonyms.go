@@ -0,0 +1,366 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// Names of the synonym checks
+const (
+	CheckDuplicateSynonym     = "duplicate-synonym"
+	CheckOneWayCycle          = "one-way-cycle"
+	CheckConflictingGroups    = "conflicting-groups"
+	CheckUnusedPlaceholder    = "unused-placeholder"
+	CheckAltCorrectionOverlap = "alt-correction-overlap"
+)
+
+// SynonymsOptions are the data the synonyms are checked against.
+// Checks that need data that isn't available (nil) are skipped.
+type SynonymsOptions struct {
+	// Placeholders are the placeholders found in the records of the index.
+	Placeholders map[string]bool
+}
+
+// Synonyms checks a set of synonyms and returns the issues found, from the most to the least important.
+func Synonyms(synonyms []search.SynonymHit, opts SynonymsOptions) []Issue {
+	issues := []Issue{}
+	duplicates, duplicateOf := duplicateSynonyms(synonyms)
+	issues = append(issues, duplicates...)
+	issues = append(issues, oneWayCycles(synonyms)...)
+	issues = append(issues, conflictingGroups(synonyms, duplicateOf)...)
+	issues = append(issues, altCorrectionOverlaps(synonyms)...)
+	if opts.Placeholders != nil {
+		issues = append(issues, unusedPlaceholders(synonyms, opts.Placeholders)...)
+	}
+	sortIssues(issues)
+	return issues
+}
+
+// kind returns the type of a synonym, with a single spelling for each type
+// (the API accepts both oneWaySynonym and onewaysynonym for example).
+func kind(synonym search.SynonymHit) search.SynonymType {
+	switch synonym.Type {
+	case search.SYNONYM_TYPE_ONE_WAY_SYNONYM, search.SYNONYM_TYPE_ONEWAYSYNONYM:
+		return search.SYNONYM_TYPE_ONE_WAY_SYNONYM
+	case search.SYNONYM_TYPE_ALT_CORRECTION1, search.SYNONYM_TYPE_ALTCORRECTION1:
+		return search.SYNONYM_TYPE_ALT_CORRECTION1
+	case search.SYNONYM_TYPE_ALT_CORRECTION2, search.SYNONYM_TYPE_ALTCORRECTION2:
+		return search.SYNONYM_TYPE_ALT_CORRECTION2
+	case search.SYNONYM_TYPE_PLACEHOLDER:
+		return search.SYNONYM_TYPE_PLACEHOLDER
+	default:
+		return search.SYNONYM_TYPE_SYNONYM
+	}
+}
+
+// normalizeWord makes the words of synonyms comparable: the engine ignores the case.
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.Join(strings.Fields(word), " "))
+}
+
+func normalizeWords(words []string) []string {
+	normalized := make([]string, 0, len(words))
+	for _, w := range words {
+		normalized = append(normalized, normalizeWord(w))
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// synonymKey returns a key that's identical for synonyms with the same effect.
+func synonymKey(synonym search.SynonymHit) string {
+	var from string
+	var to []string
+	k := kind(synonym)
+	switch k {
+	case search.SYNONYM_TYPE_ONE_WAY_SYNONYM:
+		from, to = synonym.GetInput(), synonym.Synonyms
+	case search.SYNONYM_TYPE_ALT_CORRECTION1, search.SYNONYM_TYPE_ALT_CORRECTION2:
+		from, to = synonym.GetWord(), synonym.Corrections
+	case search.SYNONYM_TYPE_PLACEHOLDER:
+		from, to = synonym.GetPlaceholder(), synonym.Replacements
+	default:
+		to = synonym.Synonyms
+	}
+	return fmt.Sprintf("%s|%s|%s", k, normalizeWord(from), strings.Join(normalizeWords(to), ","))
+}
+
+// duplicateSynonyms reports the synonyms with the same effect as another one.
+// It also returns the objectID of the first synonym of each group of duplicates, by objectID.
+func duplicateSynonyms(synonyms []search.SynonymHit) ([]Issue, map[string]string) {
+	first := map[string]string{}
+	duplicateOf := map[string]string{}
+
+	var issues []Issue
+	for _, synonym := range synonyms {
+		key := synonymKey(synonym)
+		id, ok := first[key]
+		if !ok {
+			first[key] = synonym.ObjectID
+			continue
+		}
+		duplicateOf[synonym.ObjectID] = id
+		issues = append(issues, Issue{
+			Severity: Error,
+			ObjectID: synonym.ObjectID,
+			Check:    CheckDuplicateSynonym,
+			Message:  fmt.Sprintf("same synonyms as %s", id),
+		})
+	}
+	return issues, duplicateOf
+}
+
+// oneWayCycles reports the one-way synonyms that form cycles, like `a => b` and `b => a`.
+// Regular synonyms would be simpler for these words.
+func oneWayCycles(synonyms []search.SynonymHit) []Issue {
+	graph := map[string][]string{}
+	for _, synonym := range synonyms {
+		if kind(synonym) != search.SYNONYM_TYPE_ONE_WAY_SYNONYM {
+			continue
+		}
+		input := normalizeWord(synonym.GetInput())
+		for _, s := range synonym.Synonyms {
+			graph[input] = append(graph[input], normalizeWord(s))
+		}
+	}
+
+	components := stronglyConnectedComponents(graph)
+
+	var issues []Issue
+	for _, synonym := range synonyms {
+		if kind(synonym) != search.SYNONYM_TYPE_ONE_WAY_SYNONYM {
+			continue
+		}
+		input := normalizeWord(synonym.GetInput())
+		component, ok := components[input]
+		if !ok {
+			continue
+		}
+		for _, s := range synonym.Synonyms {
+			if components[normalizeWord(s)] == component {
+				issues = append(issues, Issue{
+					Severity: Warning,
+					ObjectID: synonym.ObjectID,
+					Check:    CheckOneWayCycle,
+					Message: fmt.Sprintf(
+						"one-way synonyms form a cycle between: %s",
+						component,
+					),
+				})
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// stronglyConnectedComponents returns the words that are in a cycle of the graph,
+// with the sorted words of their cycle (Tarjan's algorithm).
+func stronglyConnectedComponents(graph map[string][]string) map[string]string {
+	var (
+		index    = 0
+		indices  = map[string]int{}
+		lowLinks = map[string]int{}
+		onStack  = map[string]bool{}
+		stack    []string
+		result   = map[string]string{}
+	)
+
+	var visit func(word string)
+	visit = func(word string) {
+		indices[word] = index
+		lowLinks[word] = index
+		index++
+		stack = append(stack, word)
+		onStack[word] = true
+
+		for _, next := range graph[word] {
+			if _, visited := indices[next]; !visited {
+				visit(next)
+				lowLinks[word] = min(lowLinks[word], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[word] = min(lowLinks[word], indices[next])
+			}
+		}
+
+		if lowLinks[word] != indices[word] {
+			return
+		}
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == word {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Strings(component)
+			for _, w := range component {
+				result[w] = strings.Join(component, ", ")
+			}
+		}
+	}
+
+	words := make([]string, 0, len(graph))
+	for word := range graph {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	for _, word := range words {
+		if _, visited := indices[word]; !visited {
+			visit(word)
+		}
+	}
+	return result
+}
+
+// conflictingGroups reports the words that are in several regular synonyms:
+// synonyms aren't transitive, so the words of these groups get different alternatives.
+func conflictingGroups(synonyms []search.SynonymHit, duplicateOf map[string]string) []Issue {
+	groups := map[string][]string{}
+	for _, synonym := range synonyms {
+		if kind(synonym) != search.SYNONYM_TYPE_SYNONYM || duplicateOf[synonym.ObjectID] != "" {
+			continue
+		}
+		seen := map[string]bool{}
+		for _, w := range synonym.Synonyms {
+			w = normalizeWord(w)
+			if !seen[w] {
+				seen[w] = true
+				groups[w] = append(groups[w], synonym.ObjectID)
+			}
+		}
+	}
+
+	var issues []Issue
+	for _, synonym := range synonyms {
+		if kind(synonym) != search.SYNONYM_TYPE_SYNONYM || duplicateOf[synonym.ObjectID] != "" {
+			continue
+		}
+		var conflicts []string
+		seen := map[string]bool{}
+		for _, w := range synonym.Synonyms {
+			w = normalizeWord(w)
+			if seen[w] {
+				continue
+			}
+			seen[w] = true
+			var others []string
+			for _, id := range groups[w] {
+				if id != synonym.ObjectID {
+					others = append(others, id)
+				}
+			}
+			if len(others) > 0 {
+				conflicts = append(conflicts, fmt.Sprintf("%s (%s)", w, strings.Join(others, ", ")))
+			}
+		}
+		if len(conflicts) > 0 {
+			issues = append(issues, Issue{
+				Severity: Warning,
+				ObjectID: synonym.ObjectID,
+				Check:    CheckConflictingGroups,
+				Message:  fmt.Sprintf("words in other synonyms: %s", strings.Join(conflicts, ", ")),
+			})
+		}
+	}
+	return issues
+}
+
+// altCorrectionOverlaps reports the alternative corrections that are also synonyms:
+// the engine can't both treat them as typos and as synonyms.
+func altCorrectionOverlaps(synonyms []search.SynonymHit) []Issue {
+	type pair struct{ from, to string }
+	synonymOf := map[pair]string{}
+	for _, synonym := range synonyms {
+		switch kind(synonym) {
+		case search.SYNONYM_TYPE_SYNONYM:
+			words := normalizeWords(synonym.Synonyms)
+			for _, a := range words {
+				for _, b := range words {
+					if a != b {
+						synonymOf[pair{a, b}] = synonym.ObjectID
+					}
+				}
+			}
+		case search.SYNONYM_TYPE_ONE_WAY_SYNONYM:
+			input := normalizeWord(synonym.GetInput())
+			for _, s := range synonym.Synonyms {
+				synonymOf[pair{input, normalizeWord(s)}] = synonym.ObjectID
+			}
+		}
+	}
+
+	var issues []Issue
+	for _, synonym := range synonyms {
+		k := kind(synonym)
+		if k != search.SYNONYM_TYPE_ALT_CORRECTION1 && k != search.SYNONYM_TYPE_ALT_CORRECTION2 {
+			continue
+		}
+		word := normalizeWord(synonym.GetWord())
+		var overlaps []string
+		for _, c := range synonym.Corrections {
+			if id, ok := synonymOf[pair{word, normalizeWord(c)}]; ok {
+				overlaps = append(overlaps, fmt.Sprintf("%s (%s)", normalizeWord(c), id))
+			}
+		}
+		if len(overlaps) > 0 {
+			issues = append(issues, Issue{
+				Severity: Warning,
+				ObjectID: synonym.ObjectID,
+				Check:    CheckAltCorrectionOverlap,
+				Message: fmt.Sprintf(
+					"corrections of %q that are also synonyms: %s",
+					word,
+					strings.Join(overlaps, ", "),
+				),
+			})
+		}
+	}
+	return issues
+}
+
+// unusedPlaceholders reports the placeholders that aren't in any record.
+func unusedPlaceholders(synonyms []search.SynonymHit, placeholders map[string]bool) []Issue {
+	var issues []Issue
+	for _, synonym := range synonyms {
+		if kind(synonym) != search.SYNONYM_TYPE_PLACEHOLDER {
+			continue
+		}
+		if !placeholders[synonym.GetPlaceholder()] {
+			issues = append(issues, Issue{
+				Severity: Warning,
+				ObjectID: synonym.ObjectID,
+				Check:    CheckUnusedPlaceholder,
+				Message:  fmt.Sprintf("no record contains the placeholder %s", synonym.GetPlaceholder()),
+			})
+		}
+	}
+	return issues
+}
+
+// PlaceholdersIn returns the placeholders found in a value of a record, like a string or a nested object.
+func PlaceholdersIn(value interface{}, placeholders []string, found map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		for _, p := range placeholders {
+			if !found[p] && strings.Contains(v, p) {
+				found[p] = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			PlaceholdersIn(e, placeholders, found)
+		}
+	case map[string]interface{}:
+		for _, e := range v {
+			PlaceholdersIn(e, placeholders, found)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseSynonyms(t *testing.T, synonyms ...string) []search.SynonymHit {
+	t.Helper()
+	var res []search.SynonymHit
+	for _, s := range synonyms {
+		var synonym search.SynonymHit
+		require.NoError(t, json.Unmarshal([]byte(s), &synonym))
+		res = append(res, synonym)
+	}
+	return res
+}
+
+func TestSynonyms(t *testing.T) {
+	tests := []struct {
+		name     string
+		synonyms []string
+		opts     SynonymsOptions
+		want     []Issue
+	}{
+		{
+			name: "duplicates",
+			synonyms: []string{
+				`{"objectID":"1","type":"synonym","synonyms":["TV","television"]}`,
+				`{"objectID":"2","type":"synonym","synonyms":["television","tv"]}`,
+				`{"objectID":"3","type":"oneWaySynonym","input":"tv","synonyms":["television"]}`,
+				`{"objectID":"4","type":"onewaysynonym","input":"TV","synonyms":["television"]}`,
+			},
+			want: []Issue{
+				{Severity: Error, ObjectID: "2", Check: CheckDuplicateSynonym, Message: "same synonyms as 1"},
+				{Severity: Error, ObjectID: "4", Check: CheckDuplicateSynonym, Message: "same synonyms as 3"},
+			},
+		},
+		{
+			name: "one-way cycles",
+			synonyms: []string{
+				`{"objectID":"1","type":"oneWaySynonym","input":"a","synonyms":["b"]}`,
+				`{"objectID":"2","type":"oneWaySynonym","input":"b","synonyms":["c"]}`,
+				`{"objectID":"3","type":"oneWaySynonym","input":"c","synonyms":["a","d"]}`,
+				`{"objectID":"4","type":"oneWaySynonym","input":"d","synonyms":["e"]}`,
+			},
+			want: []Issue{
+				{Severity: Warning, ObjectID: "1", Check: CheckOneWayCycle, Message: "one-way synonyms form a cycle between: a, b, c"},
+				{Severity: Warning, ObjectID: "2", Check: CheckOneWayCycle, Message: "one-way synonyms form a cycle between: a, b, c"},
+				{Severity: Warning, ObjectID: "3", Check: CheckOneWayCycle, Message: "one-way synonyms form a cycle between: a, b, c"},
+			},
+		},
+		{
+			name: "conflicting groups",
+			synonyms: []string{
+				`{"objectID":"1","type":"synonym","synonyms":["tv","television"]}`,
+				`{"objectID":"2","type":"synonym","synonyms":["TV","screen"]}`,
+				`{"objectID":"3","type":"synonym","synonyms":["laptop","notebook"]}`,
+			},
+			want: []Issue{
+				{Severity: Warning, ObjectID: "1", Check: CheckConflictingGroups, Message: "words in other synonyms: tv (2)"},
+				{Severity: Warning, ObjectID: "2", Check: CheckConflictingGroups, Message: "words in other synonyms: tv (1)"},
+			},
+		},
+		{
+			name: "alt corrections that are also synonyms",
+			synonyms: []string{
+				`{"objectID":"1","type":"synonym","synonyms":["color","colour"]}`,
+				`{"objectID":"2","type":"altCorrection1","word":"color","corrections":["colour","colors"]}`,
+				`{"objectID":"3","type":"altcorrection2","word":"grey","corrections":["gray"]}`,
+			},
+			want: []Issue{
+				{
+					Severity: Warning,
+					ObjectID: "2",
+					Check:    CheckAltCorrectionOverlap,
+					Message:  `corrections of "color" that are also synonyms: colour (1)`,
+				},
+			},
+		},
+		{
+			name: "placeholders aren't checked without records",
+			synonyms: []string{
+				`{"objectID":"1","type":"placeholder","placeholder":"<street>","replacements":["street","st"]}`,
+			},
+		},
+		{
+			name: "unused placeholders",
+			synonyms: []string{
+				`{"objectID":"1","type":"placeholder","placeholder":"<street>","replacements":["street","st"]}`,
+				`{"objectID":"2","type":"placeholder","placeholder":"<avenue>","replacements":["avenue","av"]}`,
+			},
+			opts: SynonymsOptions{Placeholders: map[string]bool{"<street>": true}},
+			want: []Issue{
+				{Severity: Warning, ObjectID: "2", Check: CheckUnusedPlaceholder, Message: "no record contains the placeholder <avenue>"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Synonyms(parseSynonyms(t, tt.synonyms...), tt.opts)
+			if tt.want == nil {
+				tt.want = []Issue{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlaceholdersIn(t *testing.T) {
+	found := map[string]bool{}
+	PlaceholdersIn(map[string]interface{}{
+		"name":    "Main",
+		"address": map[string]interface{}{"lines": []interface{}{"12 <street> Main"}},
+		"number":  12.0,
+	}, []string{"<street>", "<avenue>"}, found)
+	assert.Equal(t, map[string]bool{"<street>": true}, found)
+}
@@ -0,0 +1,203 @@
+package relevance
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// AssertionResult is the outcome of an assertion.
+type AssertionResult struct {
+	Assertion string `json:"assertion"`
+	Passed    bool   `json:"passed"`
+	Message   string `json:"message"`
+}
+
+// TestResult is the outcome of a test.
+type TestResult struct {
+	Name  string `json:"name"`
+	Index string `json:"index"`
+	Query string `json:"query"`
+	// Error is the error of the search, if it failed. The assertions aren't evaluated then.
+	Error            string            `json:"error,omitempty"`
+	ProcessingTimeMS int32             `json:"processingTimeMS"`
+	Assertions       []AssertionResult `json:"assertions"`
+}
+
+// Passed returns true if the search succeeded and all its assertions passed.
+func (r TestResult) Passed() bool {
+	if r.Error != "" {
+		return false
+	}
+	for _, a := range r.Assertions {
+		if !a.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate checks the assertions of a test against the results of its search.
+func Evaluate(test Test, res *search.SearchResponse) TestResult {
+	result := TestResult{
+		Name:             test.Name,
+		Index:            test.Index,
+		Query:            test.Query,
+		ProcessingTimeMS: res.ProcessingTimeMS,
+	}
+	for _, assertion := range test.Assert {
+		result.Assertions = append(result.Assertions, assertion.Evaluate(res))
+	}
+	return result
+}
+
+func (a Assertion) String() string {
+	switch {
+	case a.ObjectID != "" && a.InTop > 0:
+		return fmt.Sprintf("%s in top %d", a.ObjectID, a.InTop)
+	case a.ObjectID != "":
+		return fmt.Sprintf("%s in results", a.ObjectID)
+	case a.NotInResults != "":
+		return fmt.Sprintf("%s not in results", a.NotInResults)
+	case a.NbHits != nil:
+		return fmt.Sprintf("nbHits %s", a.NbHits)
+	default:
+		var parts []string
+		for _, path := range sortedKeys(a.FirstHit) {
+			parts = append(parts, fmt.Sprintf("%s=%s", path, formatValue(a.FirstHit[path])))
+		}
+		return "first hit has " + strings.Join(parts, ", ")
+	}
+}
+
+// Evaluate checks the assertion against the results of a search.
+func (a Assertion) Evaluate(res *search.SearchResponse) AssertionResult {
+	result := AssertionResult{Assertion: a.String()}
+
+	switch {
+	case a.ObjectID != "":
+		position := hitPosition(res.Hits, a.ObjectID)
+		switch {
+		case position == 0:
+			result.Message = fmt.Sprintf("not in the %d hits", len(res.Hits))
+		case a.InTop > 0 && position > a.InTop:
+			result.Message = fmt.Sprintf("at position %d", position)
+		default:
+			result.Passed = true
+			result.Message = fmt.Sprintf("at position %d", position)
+		}
+
+	case a.NotInResults != "":
+		if position := hitPosition(res.Hits, a.NotInResults); position > 0 {
+			result.Message = fmt.Sprintf("at position %d", position)
+		} else {
+			result.Passed = true
+			result.Message = fmt.Sprintf("not in the %d hits", len(res.Hits))
+		}
+
+	case a.NbHits != nil:
+		nbHits := int(res.GetNbHits())
+		result.Passed = a.NbHits.Match(nbHits)
+		result.Message = fmt.Sprintf("%d hits", nbHits)
+
+	default:
+		if len(res.Hits) == 0 {
+			result.Message = "no hits"
+			break
+		}
+		first := res.Hits[0]
+		var mismatches []string
+		for _, path := range sortedKeys(a.FirstHit) {
+			value, ok := lookup(first.AdditionalProperties, path)
+			// The objectID isn't in the additional properties of a hit
+			if path == "objectID" {
+				value, ok = first.ObjectID, first.ObjectID != ""
+			}
+			if !ok {
+				mismatches = append(mismatches, fmt.Sprintf("%s is missing", path))
+			} else if !matchValue(value, a.FirstHit[path]) {
+				mismatches = append(mismatches, fmt.Sprintf("%s=%s", path, formatValue(value)))
+			}
+		}
+		if len(mismatches) > 0 {
+			result.Message = fmt.Sprintf("%s has %s", first.ObjectID, strings.Join(mismatches, ", "))
+		} else {
+			result.Passed = true
+			result.Message = fmt.Sprintf("%s matches", first.ObjectID)
+		}
+	}
+
+	return result
+}
+
+// hitPosition returns the position of the hit, starting at 1, or 0 if it isn't in the hits.
+func hitPosition(hits []search.Hit, objectID string) int {
+	for i, hit := range hits {
+		if hit.ObjectID == objectID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// lookup returns the value of a dotted path, like "author.name", in a record.
+func lookup(record map[string]any, path string) (any, bool) {
+	var value any = record
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		value, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// matchValue returns true if the value equals the expected value,
+// or if the value is a list containing the expected value.
+func matchValue(value any, expected any) bool {
+	if equalJSON(value, expected) {
+		return true
+	}
+	if list, ok := value.([]any); ok {
+		for _, v := range list {
+			if equalJSON(v, expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// equalJSON compares values by their JSON encoding, so that numbers of different types are equal.
+func equalJSON(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+func formatValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
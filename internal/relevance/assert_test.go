@@ -0,0 +1,128 @@
+package relevance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const response = `{
+	"hits":[
+		{"objectID":"1","brand":"Apple","price":999,"tags":["phone","new"],"seller":{"name":"Acme"}},
+		{"objectID":"2","brand":"Samsung"},
+		{"objectID":"3","brand":"Apple"}
+	],
+	"nbHits":25,"page":0,"nbPages":9,"hitsPerPage":3,"processingTimeMS":2,"query":"phone","params":""
+}`
+
+func TestAssertion_Evaluate(t *testing.T) {
+	var res search.SearchResponse
+	require.NoError(t, json.Unmarshal([]byte(response), &res))
+
+	tests := []struct {
+		name      string
+		assertion Assertion
+		want      AssertionResult
+	}{
+		{
+			name:      "in top",
+			assertion: Assertion{ObjectID: "2", InTop: 2},
+			want:      AssertionResult{Assertion: "2 in top 2", Passed: true, Message: "at position 2"},
+		},
+		{
+			name:      "not in top",
+			assertion: Assertion{ObjectID: "3", InTop: 2},
+			want:      AssertionResult{Assertion: "3 in top 2", Message: "at position 3"},
+		},
+		{
+			name:      "not in results",
+			assertion: Assertion{ObjectID: "4"},
+			want:      AssertionResult{Assertion: "4 in results", Message: "not in the 3 hits"},
+		},
+		{
+			name:      "excluded",
+			assertion: Assertion{NotInResults: "4"},
+			want:      AssertionResult{Assertion: "4 not in results", Passed: true, Message: "not in the 3 hits"},
+		},
+		{
+			name:      "not excluded",
+			assertion: Assertion{NotInResults: "1"},
+			want:      AssertionResult{Assertion: "1 not in results", Message: "at position 1"},
+		},
+		{
+			name:      "nbHits",
+			assertion: Assertion{NbHits: &Comparison{Operator: ">", Value: 10}},
+			want:      AssertionResult{Assertion: "nbHits > 10", Passed: true, Message: "25 hits"},
+		},
+		{
+			name: "first hit",
+			assertion: Assertion{FirstHit: map[string]any{
+				"brand":       "Apple",
+				"price":       999,
+				"tags":        "phone",
+				"seller.name": "Acme",
+			}},
+			want: AssertionResult{
+				Assertion: "first hit has brand=Apple, price=999, seller.name=Acme, tags=phone",
+				Passed:    true,
+				Message:   "1 matches",
+			},
+		},
+		{
+			name:      "first hit objectID",
+			assertion: Assertion{FirstHit: map[string]any{"objectID": "1"}},
+			want: AssertionResult{
+				Assertion: "first hit has objectID=1",
+				Passed:    true,
+				Message:   "1 matches",
+			},
+		},
+		{
+			name:      "first hit objectID mismatch",
+			assertion: Assertion{FirstHit: map[string]any{"objectID": "2"}},
+			want: AssertionResult{
+				Assertion: "first hit has objectID=2",
+				Message:   "1 has objectID=1",
+			},
+		},
+		{
+			name:      "first hit mismatch",
+			assertion: Assertion{FirstHit: map[string]any{"brand": "Samsung", "color": "red"}},
+			want: AssertionResult{
+				Assertion: "first hit has brand=Samsung, color=red",
+				Message:   "1 has brand=Apple, color is missing",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.assertion.Evaluate(&res))
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	var res search.SearchResponse
+	require.NoError(t, json.Unmarshal([]byte(response), &res))
+
+	result := Evaluate(Test{
+		Name:   "phone",
+		Index:  "PRODUCTS",
+		Query:  "phone",
+		Assert: []Assertion{{ObjectID: "1", InTop: 1}, {NotInResults: "2"}},
+	}, &res)
+
+	assert.Equal(t, int32(2), result.ProcessingTimeMS)
+	assert.Len(t, result.Assertions, 2)
+	assert.False(t, result.Passed())
+
+	result.Assertions = result.Assertions[:1]
+	assert.True(t, result.Passed())
+
+	result.Error = "index does not exist"
+	assert.False(t, result.Passed())
+}
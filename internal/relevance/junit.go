@@ -0,0 +1,97 @@
+package relevance
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the results as a JUnit XML report, with one test case per test.
+// The class name of a test case is its index.
+func WriteJUnit(w io.Writer, name string, results []TestResult, duration time.Duration) error {
+	suite := junitTestSuite{
+		Name:  name,
+		Tests: len(results),
+		Time:  seconds(duration),
+	}
+	for _, result := range results {
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: result.Index,
+			Time:      seconds(time.Duration(result.ProcessingTimeMS) * time.Millisecond),
+		}
+		if result.Error != "" {
+			suite.Errors++
+			testCase.Error = &junitMessage{Message: result.Error, Text: result.Error}
+		} else if !result.Passed() {
+			suite.Failures++
+			var failed []string
+			for _, a := range result.Assertions {
+				if !a.Passed {
+					failed = append(failed, fmt.Sprintf("%s: %s", a.Assertion, a.Message))
+				}
+			}
+			testCase.Failure = &junitMessage{
+				Message: fmt.Sprintf("%d of %d assertions failed", len(failed), len(result.Assertions)),
+				Text:    strings.Join(failed, "\n"),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	report := junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,46 @@
+package relevance
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	results := []TestResult{
+		{
+			Name:             "iphone",
+			Index:            "PRODUCTS",
+			ProcessingTimeMS: 3,
+			Assertions:       []AssertionResult{{Assertion: "1 in top 3", Passed: true, Message: "at position 1"}},
+		},
+		{
+			Name:  "samsung",
+			Index: "PRODUCTS",
+			Assertions: []AssertionResult{
+				{Assertion: "2 in top 3", Passed: true, Message: "at position 2"},
+				{Assertion: "nbHits > 10", Message: "4 hits"},
+			},
+		},
+		{Name: "pixel", Index: "MISSING", Error: "Index does not exist"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJUnit(&buf, "suite.yaml", results, 1500*time.Millisecond))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" failures="1" errors="1" time="1.500">
+  <testsuite name="suite.yaml" tests="3" failures="1" errors="1" time="1.500">
+    <testcase name="iphone" classname="PRODUCTS" time="0.003"></testcase>
+    <testcase name="samsung" classname="PRODUCTS" time="0.000">
+      <failure message="1 of 2 assertions failed">nbHits &gt; 10: 4 hits</failure>
+    </testcase>
+    <testcase name="pixel" classname="MISSING" time="0.000">
+      <error message="Index does not exist">Index does not exist</error>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, buf.String())
+}
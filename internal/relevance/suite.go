@@ -0,0 +1,205 @@
+// Package relevance runs relevance test suites: searches with assertions on their results.
+package relevance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// Suite is a list of searches, with the assertions on their results.
+type Suite struct {
+	// Index is the default index of the tests.
+	Index string `json:"index,omitempty"`
+	// Params are the default search parameters of the tests.
+	Params map[string]any `json:"params,omitempty"`
+	Tests  []Test         `json:"tests"`
+}
+
+// Test is a search with assertions on its results.
+type Test struct {
+	Name   string         `json:"name,omitempty"`
+	Index  string         `json:"index,omitempty"`
+	Query  string         `json:"query"`
+	Params map[string]any `json:"params,omitempty"`
+	Assert []Assertion    `json:"assert"`
+}
+
+// Assertion is a condition on the results of a search.
+// Exactly one of ObjectID, NotInResults, NbHits or FirstHit is set.
+type Assertion struct {
+	// ObjectID must be in the results, in the first InTop hits if InTop is set.
+	ObjectID string `json:"objectID,omitempty"`
+	InTop    int    `json:"inTop,omitempty"`
+	// NotInResults must not be in the results.
+	NotInResults string `json:"notInResults,omitempty"`
+	// NbHits is a comparison with the number of hits, like "> 10".
+	NbHits *Comparison `json:"nbHits,omitempty"`
+	// FirstHit has the attributes that the first hit must have.
+	// Attributes can be nested, like "author.name".
+	FirstHit map[string]any `json:"firstHit,omitempty"`
+}
+
+// Comparison compares a number with a value, like "> 10".
+type Comparison struct {
+	Operator string
+	Value    int
+}
+
+var operators = []string{">=", "<=", "==", ">", "<", "="}
+
+// ParseComparison parses a comparison like "> 10" or "10", which means "== 10".
+func ParseComparison(s string) (Comparison, error) {
+	input := s
+	s = strings.TrimSpace(s)
+	operator := "=="
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			operator = op
+			s = strings.TrimSpace(strings.TrimPrefix(s, op))
+			break
+		}
+	}
+	if operator == "=" {
+		operator = "=="
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return Comparison{}, fmt.Errorf("invalid comparison %q, expected an operator (>, >=, <, <=, ==) and a number", input)
+	}
+	return Comparison{Operator: operator, Value: value}, nil
+}
+
+// UnmarshalJSON accepts a number or a comparison string.
+func (c *Comparison) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*c = Comparison{Operator: "==", Value: n}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid comparison %s, expected a number or a string like \"> 10\"", data)
+	}
+	parsed, err := ParseComparison(s)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON writes the comparison as a string.
+func (c Comparison) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c Comparison) String() string {
+	return fmt.Sprintf("%s %d", c.Operator, c.Value)
+}
+
+// Match returns true if the number satisfies the comparison.
+func (c Comparison) Match(n int) bool {
+	switch c.Operator {
+	case ">":
+		return n > c.Value
+	case ">=":
+		return n >= c.Value
+	case "<":
+		return n < c.Value
+	case "<=":
+		return n <= c.Value
+	default:
+		return n == c.Value
+	}
+}
+
+// ParseSuite parses a suite from YAML or JSON, and checks its tests.
+func ParseSuite(data []byte) (*Suite, error) {
+	docs, err := utils.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) != 1 {
+		return nil, fmt.Errorf("expected one suite in the file, got %d documents", len(docs))
+	}
+
+	var suite Suite
+	decoder := json.NewDecoder(bytes.NewReader(docs[0]))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&suite); err != nil {
+		return nil, fmt.Errorf("invalid suite: %w", err)
+	}
+
+	if len(suite.Tests) == 0 {
+		return nil, fmt.Errorf("invalid suite: no tests")
+	}
+	for i := range suite.Tests {
+		test := &suite.Tests[i]
+		if test.Name == "" {
+			test.Name = test.Query
+		}
+		if test.Index == "" {
+			test.Index = suite.Index
+		}
+		if test.Index == "" {
+			return nil, fmt.Errorf("invalid test %d (%q): no index", i+1, test.Name)
+		}
+		if len(test.Assert) == 0 {
+			return nil, fmt.Errorf("invalid test %d (%q): no assertions", i+1, test.Name)
+		}
+		for _, assertion := range test.Assert {
+			if err := assertion.validate(); err != nil {
+				return nil, fmt.Errorf("invalid test %d (%q): %w", i+1, test.Name, err)
+			}
+		}
+	}
+
+	return &suite, nil
+}
+
+func (a Assertion) validate() error {
+	kinds := 0
+	for _, set := range []bool{a.ObjectID != "", a.NotInResults != "", a.NbHits != nil, len(a.FirstHit) > 0} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("an assertion needs exactly one of objectID, notInResults, nbHits or firstHit")
+	}
+	if a.InTop < 0 || (a.InTop > 0 && a.ObjectID == "") {
+		return fmt.Errorf("inTop needs an objectID and a positive number")
+	}
+	return nil
+}
+
+// SearchParams merges the default parameters, the suite parameters and the test parameters,
+// and sets the query of the test.
+func (s *Suite) SearchParams(test Test, defaults map[string]any) (search.SearchParamsObject, error) {
+	merged := map[string]any{}
+	for _, params := range []map[string]any{defaults, s.Params, test.Params} {
+		for k, v := range params {
+			merged[k] = v
+		}
+	}
+	merged["query"] = test.Query
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return search.SearchParamsObject{}, err
+	}
+	var params search.SearchParamsObject
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&params); err != nil {
+		return search.SearchParamsObject{}, fmt.Errorf("invalid search parameters of %q: %w", test.Name, err)
+	}
+	return params, nil
+}
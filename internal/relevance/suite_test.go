@@ -0,0 +1,125 @@
+package relevance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuite(t *testing.T) {
+	suite, err := ParseSuite([]byte(`
+index: PRODUCTS
+params:
+  hitsPerPage: 20
+tests:
+  - query: iphone
+    assert:
+      - objectID: "42"
+        inTop: 3
+      - nbHits: "> 10"
+  - name: samsung on another index
+    index: PRODUCTS_US
+    query: samsung
+    params:
+      filters: "brand:Samsung"
+    assert:
+      - nbHits: 5
+      - firstHit:
+          brand: Samsung
+`))
+	require.NoError(t, err)
+	require.Len(t, suite.Tests, 2)
+
+	assert.Equal(t, "iphone", suite.Tests[0].Name)
+	assert.Equal(t, "PRODUCTS", suite.Tests[0].Index)
+	assert.Equal(t, Assertion{ObjectID: "42", InTop: 3}, suite.Tests[0].Assert[0])
+	assert.Equal(t, &Comparison{Operator: ">", Value: 10}, suite.Tests[0].Assert[1].NbHits)
+
+	assert.Equal(t, "PRODUCTS_US", suite.Tests[1].Index)
+	assert.Equal(t, &Comparison{Operator: "==", Value: 5}, suite.Tests[1].Assert[0].NbHits)
+
+	params, err := suite.SearchParams(suite.Tests[1], map[string]any{"hitsPerPage": 5, "analytics": false})
+	require.NoError(t, err)
+	assert.Equal(t, "samsung", params.GetQuery())
+	assert.Equal(t, int32(20), params.GetHitsPerPage())
+	assert.Equal(t, "brand:Samsung", params.GetFilters())
+	assert.False(t, params.GetAnalytics())
+}
+
+func TestParseSuite_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		suite   string
+		wantErr string
+	}{
+		{
+			name:    "no tests",
+			suite:   "index: PRODUCTS",
+			wantErr: "invalid suite: no tests",
+		},
+		{
+			name:    "unknown field",
+			suite:   "tests:\n  - query: a\n    asert: []",
+			wantErr: `invalid suite: json: unknown field "asert"`,
+		},
+		{
+			name:    "no index",
+			suite:   "tests:\n  - query: a\n    assert:\n      - nbHits: 1",
+			wantErr: `invalid test 1 ("a"): no index`,
+		},
+		{
+			name:    "several kinds of assertions",
+			suite:   "index: A\ntests:\n  - query: a\n    assert:\n      - nbHits: 1\n        notInResults: b",
+			wantErr: `invalid test 1 ("a"): an assertion needs exactly one of objectID, notInResults, nbHits or firstHit`,
+		},
+		{
+			name:    "inTop without objectID",
+			suite:   "index: A\ntests:\n  - query: a\n    assert:\n      - notInResults: b\n        inTop: 3",
+			wantErr: `invalid test 1 ("a"): inTop needs an objectID and a positive number`,
+		},
+		{
+			name:    "invalid comparison",
+			suite:   "index: A\ntests:\n  - query: a\n    assert:\n      - nbHits: '~ 3'",
+			wantErr: `invalid suite: invalid comparison "~ 3", expected an operator (>, >=, <, <=, ==) and a number`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSuite([]byte(tt.suite))
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSearchParams_unknownParameter(t *testing.T) {
+	suite := &Suite{Params: map[string]any{"hitPerPage": 3}}
+	_, err := suite.SearchParams(Test{Name: "a", Query: "a"}, nil)
+	assert.EqualError(t, err, `invalid search parameters of "a": json: unknown field "hitPerPage"`)
+}
+
+func TestComparison(t *testing.T) {
+	tests := []struct {
+		comparison string
+		n          int
+		want       bool
+	}{
+		{"> 10", 11, true},
+		{">10", 10, false},
+		{">= 10", 10, true},
+		{"< 3", 3, false},
+		{"<= 3", 3, true},
+		{"= 0", 0, true},
+		{"== 2", 1, false},
+		{"7", 7, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.comparison, func(t *testing.T) {
+			c, err := ParseComparison(tt.comparison)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c.Match(tt.n))
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package browse
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/MakeNowJust/heredoc"
@@ -111,62 +110,16 @@ func runBrowseCmd(opts *BrowseOptions) error {
 	hasNoEntries := true
 
 	for _, dictionary := range opts.Dictionaries {
-		var pageCount int32 = 0
-		var maxPages int32 = 1
-
-		// Infinite pagination
-		for pageCount < maxPages {
-			res, err := client.SearchDictionaryEntries(
-				client.NewApiSearchDictionaryEntriesRequest(
-					dictionary,
-					search.NewEmptySearchDictionaryEntriesParams().
-						SetHitsPerPage(1000).
-						SetPage(pageCount).
-						SetQuery(""),
-				),
-			)
-			if err != nil {
-				return err
-			}
-
-			maxPages = res.NbPages
-
-			data, err := json.Marshal(res.Hits)
-			if err != nil {
-				return fmt.Errorf(
-					"cannot unmarshal dictionary entries: error while marshalling original dictionary entries: %v",
-					err,
-				)
-			}
-
-			var entries []search.DictionaryEntry
-			err = json.Unmarshal(data, &entries)
-			if err != nil {
-				return fmt.Errorf(
-					"cannot unmarshal dictionary entries: error while unmarshalling original dictionary entries: %v",
-					err,
-				)
-			}
-
-			if len(entries) != 0 {
-				hasNoEntries = false
+		err := shared.BrowseEntries(client, dictionary, "", func(entry search.DictionaryEntry) error {
+			hasNoEntries = false
+			if opts.IncludeDefaultStopwords || shared.IsCustom(entry) {
+				// Print only custom entries, unless the default Algolia stop words are included
+				return p.Print(opts.IO, entry)
 			}
-
-			for _, entry := range entries {
-				if opts.IncludeDefaultStopwords {
-					// Print all entries (inlcuding the default Algolia stop words)
-					if err = p.Print(opts.IO, entry); err != nil {
-						return err
-					}
-				} else if *entry.Type == search.DICTIONARY_ENTRY_TYPE_CUSTOM {
-					// print only custom entries
-					if err = p.Print(opts.IO, entry); err != nil {
-						return err
-					}
-				}
-			}
-
-			pageCount++
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
 		// If no entry is found in all the dictionaries
@@ -0,0 +1,384 @@
+package diff
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/dictionary/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// Changes between the entries of the directory and the entries of the application
+const (
+	// ChangeMissing is an entry of the directory that isn't in the application
+	ChangeMissing = "missing"
+	// ChangeExtra is an entry of the application that isn't in the directory
+	ChangeExtra = "extra"
+	// ChangeChanged is an entry with different words, or a different state, in the directory and in the application
+	ChangeChanged = "changed"
+)
+
+type DiffOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Dictionaries []search.DictionaryType
+	All          bool
+	Languages    []search.SupportedLanguage
+	Directory    string
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// Difference is a custom entry that's different in the directory and in the application.
+type Difference struct {
+	Dictionary  search.DictionaryType    `json:"dictionary"`
+	Language    search.SupportedLanguage `json:"language"`
+	Change      string                   `json:"change"`
+	Application *search.DictionaryEntry  `json:"application,omitempty"`
+	Directory   *search.DictionaryEntry  `json:"directory,omitempty"`
+}
+
+// NewDiffCmd creates and returns a diff command for dictionaries' entries.
+func NewDiffCmd(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
+	opts := &DiffOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var languages []string
+
+	cmd := &cobra.Command{
+		Use:       "diff {<dictionary>... | --all} [--language <language>...] [-d <directory>]",
+		Args:      cobra.OnlyValidArgs,
+		ValidArgs: shared.DictionaryTypes(),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return shared.DictionaryTypes(), cobra.ShellCompDirectiveNoFileComp
+		},
+		Annotations: map[string]string{
+			"acls": "settings",
+		},
+		Short: "Compare custom dictionary entries with the files of a directory",
+		Long: heredoc.Doc(`
+			Compare the custom entries of dictionaries with the files exported by "algolia dictionary entries export",
+			and list the entries that differ:
+
+			- missing: entries of the directory that aren't in the application
+			- extra: entries of the application that aren't in the directory
+			- changed: entries with different words, or a different state
+
+			Entries are matched by their (first) word, not by their objectID,
+			so that word lists can be compared with the entries of the application.
+			The command exits with a non-zero status if it finds differences.
+		`),
+		Example: heredoc.Doc(`
+			# Compare the custom entries of all the dictionaries with the files of the "dictionaries" directory
+			$ algolia dictionary entries diff --all -d dictionaries/
+
+			# Compare the French custom stop words with the files of the current directory
+			$ algolia dictionary entries diff stopwords --language fr
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.All && len(args) > 0 || !opts.All && len(args) == 0 {
+				return cmdutil.FlagErrorf(
+					"Either specify dictionaries' names or use --all to compare all dictionaries",
+				)
+			}
+
+			if opts.All {
+				opts.Dictionaries = search.AllowedDictionaryTypeEnumValues
+			} else {
+				opts.Dictionaries = make([]search.DictionaryType, len(args))
+				for i, dict := range args {
+					opts.Dictionaries[i] = search.DictionaryType(dict)
+				}
+			}
+
+			for _, language := range languages {
+				l, err := search.NewSupportedLanguageFromValue(language)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid language %q", language)
+				}
+				opts.Languages = append(opts.Languages, *l)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDiffCmd(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Compare all dictionaries")
+	cmd.Flags().
+		StringSliceVarP(&languages, "language", "l", nil, "Only compare the entries of these languages (default: all the languages of the directory and the application)")
+	cmd.Flags().
+		StringVarP(&opts.Directory, "directory", "d", ".", "Directory with the exported files")
+	_ = cmd.MarkFlagDirname("directory")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// runDiffCmd executes the diff command
+func runDiffCmd(opts *DiffOptions) error {
+	files, err := readDirectory(opts)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	differences := []Difference{}
+	for _, dictionary := range opts.Dictionaries {
+		opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Fetching the entries of %s", dictionary))
+		var language search.SupportedLanguage
+		if len(opts.Languages) == 1 {
+			language = opts.Languages[0]
+		}
+		entries, err := shared.CustomEntries(client, dictionary, language)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		application := shared.GroupByLanguage(entries, opts.Languages)
+		for language := range files[dictionary] {
+			if _, ok := application[language]; !ok {
+				application[language] = []search.DictionaryEntry{}
+			}
+		}
+		for _, language := range shared.SortedLanguages(application) {
+			differences = append(
+				differences,
+				Diff(dictionary, language, application[language], files[dictionary][language])...,
+			)
+		}
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, differences); err != nil {
+			return err
+		}
+	} else if err := printDifferences(opts, differences); err != nil {
+		return err
+	}
+
+	if len(differences) > 0 {
+		return cmdutil.ErrSilent
+	}
+	return nil
+}
+
+// readDirectory reads the entries of the files of the directory, by dictionary and language.
+func readDirectory(
+	opts *DiffOptions,
+) (map[search.DictionaryType]map[search.SupportedLanguage][]search.DictionaryEntry, error) {
+	dirEntries, err := os.ReadDir(opts.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	dictionaries := map[search.DictionaryType]bool{}
+	for _, d := range opts.Dictionaries {
+		dictionaries[d] = true
+	}
+	languages := map[search.SupportedLanguage]bool{}
+	for _, l := range opts.Languages {
+		languages[l] = true
+	}
+
+	files := map[search.DictionaryType]map[search.SupportedLanguage][]search.DictionaryEntry{}
+	fileNames := map[string]string{}
+	for _, e := range dirEntries {
+		if e.IsDir() {
+			continue
+		}
+		dictionary, language, format, ok := shared.ParseEntriesFileName(e.Name())
+		if !ok || !dictionaries[dictionary] || (len(languages) > 0 && !languages[language]) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s-%s", dictionary, language)
+		if other, ok := fileNames[key]; ok {
+			return nil, fmt.Errorf(
+				"both %s and %s have the %s entries in %s",
+				other,
+				e.Name(),
+				dictionary,
+				language,
+			)
+		}
+		fileNames[key] = e.Name()
+
+		entries, err := shared.ReadEntriesFile(
+			filepath.Join(opts.Directory, e.Name()),
+			dictionary,
+			language,
+			format,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if files[dictionary] == nil {
+			files[dictionary] = map[search.SupportedLanguage][]search.DictionaryEntry{}
+		}
+		files[dictionary][language] = entries
+	}
+	return files, nil
+}
+
+// Diff compares the custom entries of a language of a dictionary in the application and in the directory.
+func Diff(
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+	application []search.DictionaryEntry,
+	directory []search.DictionaryEntry,
+) []Difference {
+	inApplication := map[string]search.DictionaryEntry{}
+	for _, e := range application {
+		inApplication[shared.EntryKey(e)] = e
+	}
+	inDirectory := map[string]search.DictionaryEntry{}
+	for _, e := range directory {
+		inDirectory[shared.EntryKey(e)] = e
+	}
+
+	keys := make([]string, 0, len(inApplication)+len(inDirectory))
+	for k := range inApplication {
+		keys = append(keys, k)
+	}
+	for k := range inDirectory {
+		if _, ok := inApplication[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var differences []Difference
+	for _, k := range keys {
+		a, inA := inApplication[k]
+		d, inD := inDirectory[k]
+		difference := Difference{Dictionary: dictionary, Language: language}
+		switch {
+		case !inA:
+			difference.Change = ChangeMissing
+			difference.Directory = &d
+		case !inD:
+			difference.Change = ChangeExtra
+			difference.Application = &a
+		case entryContent(dictionary, a) != entryContent(dictionary, d):
+			difference.Change = ChangeChanged
+			difference.Application = &a
+			difference.Directory = &d
+		default:
+			continue
+		}
+		differences = append(differences, difference)
+	}
+	return differences
+}
+
+// entryContent returns what matters in an entry for the engine: its words and its state.
+func entryContent(dictionary search.DictionaryType, entry search.DictionaryEntry) string {
+	state := search.DICTIONARY_ENTRY_STATE_ENABLED
+	if entry.State != nil {
+		state = *entry.State
+	}
+	return fmt.Sprintf("%s|%s", strings.Join(shared.EntryWords(dictionary, entry), ","), state)
+}
+
+// formatEntry formats an entry for the table, like a line of a word list.
+func formatEntry(dictionary search.DictionaryType, entry *search.DictionaryEntry) string {
+	s := strings.Join(shared.EntryWords(dictionary, *entry), ",")
+	if entry.State != nil && *entry.State == search.DICTIONARY_ENTRY_STATE_DISABLED {
+		s += " (disabled)"
+	}
+	return s
+}
+
+func printDifferences(opts *DiffOptions, differences []Difference) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+
+	if len(differences) == 0 {
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(
+				io.Out,
+				"%s No differences between the application and %s\n",
+				cs.SuccessIcon(),
+				opts.Directory,
+			)
+		}
+		return nil
+	}
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("DICTIONARY", nil, nil)
+		table.AddField("LANGUAGE", nil, nil)
+		table.AddField("CHANGE", nil, nil)
+		table.AddField("ENTRY", nil, nil)
+		table.EndRow()
+	}
+	for _, d := range differences {
+		var change, entry string
+		switch d.Change {
+		case ChangeMissing:
+			change = cs.Green(d.Change)
+			entry = formatEntry(d.Dictionary, d.Directory)
+		case ChangeExtra:
+			change = cs.Red(d.Change)
+			entry = formatEntry(d.Dictionary, d.Application)
+		default:
+			change = cs.Yellow(d.Change)
+			entry = fmt.Sprintf(
+				"%s -> %s",
+				formatEntry(d.Dictionary, d.Application),
+				formatEntry(d.Dictionary, d.Directory),
+			)
+		}
+		table.AddField(string(d.Dictionary), nil, nil)
+		table.AddField(string(d.Language), nil, nil)
+		table.AddField(change, nil, nil)
+		table.AddField(entry, nil, nil)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(
+			io.Out,
+			"\nFound %s between the application and %s\n",
+			utils.Pluralize(len(differences), "difference"),
+			opts.Directory,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const entriesResponse = `{"hits":[
+	{"objectID":"1","language":"fr","words":["cheval","chevaux"],"type":"custom"},
+	{"objectID":"2","language":"fr","words":["oeil","yeux"],"type":"custom"},
+	{"objectID":"3","language":"en","words":["mouse","mice"],"type":"custom"},
+	{"objectID":"4","language":"fr","words":["bal","bals"],"type":"standard"}
+],"page":0,"nbHits":4,"nbPages":1}`
+
+func Test_runDiffCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		files   map[string]string
+		isTTY   bool
+		wantOut string
+		wantErr error
+	}{
+		{
+			name: "differences",
+			cli:  "plurals",
+			files: map[string]string{
+				"plurals-fr.txt":    "# French plurals\ncheval,chevaux,chevals\nciel,cieux\n",
+				"plurals-en.ndjson": `{"objectID":"other","language":"en","words":["mouse","mice"]}` + "\n",
+				"stopwords-fr.txt":  "le\n",
+				"README.md":         "Dictionaries\n",
+			},
+			isTTY: true,
+			wantOut: "DICTIONARY  LANGUAGE  CHANGE   ENTRY\n" +
+				"plurals     fr        changed  cheval,chevaux -> cheval,chevaux,chevals\n" +
+				"plurals     fr        missing  ciel,cieux\n" +
+				"plurals     fr        extra    oeil,yeux\n" +
+				"\nFound 3 differences between the application and DIR\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name: "only one language, as JSON",
+			cli:  "plurals --language en -o json",
+			files: map[string]string{
+				"plurals-fr.txt": "cheval,chevaux\n",
+			},
+			wantOut: `[{"dictionary":"plurals","language":"en","change":"extra","application":{"language":"en","objectID":"3","type":"custom","words":["mouse","mice"]}}]` + "\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name: "no differences",
+			cli:  "plurals",
+			files: map[string]string{
+				"plurals-fr.txt": "cheval,chevaux\noeil,yeux\n",
+				"plurals-en.txt": "mouse,mice\n",
+			},
+			isTTY:   true,
+			wantOut: "✓ No differences between the application and DIR\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("POST", "1/dictionaries/plurals/search"),
+				httpmock.StringResponse(entriesResponse),
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewDiffCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli+" -d "+dir, out)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, strings.ReplaceAll(tt.wantOut, "DIR", dir), out.String())
+		})
+	}
+}
+
+func Test_runDiffCmd_duplicateFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plurals-fr.txt"), []byte("cheval,chevaux\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plurals-fr.ndjson"), []byte(""), 0o600))
+
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewDiffCmd(f, nil)
+	_, err := test.Execute(cmd, "plurals -d "+dir, out)
+	assert.EqualError(t, err, "both plurals-fr.ndjson and plurals-fr.txt have the plurals entries in fr")
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/algolia/cli/pkg/cmd/dictionary/entries/browse"
 	"github.com/algolia/cli/pkg/cmd/dictionary/entries/clear"
 	"github.com/algolia/cli/pkg/cmd/dictionary/entries/delete"
+	"github.com/algolia/cli/pkg/cmd/dictionary/entries/diff"
+	"github.com/algolia/cli/pkg/cmd/dictionary/entries/export"
 	importentries "github.com/algolia/cli/pkg/cmd/dictionary/entries/import"
 	"github.com/algolia/cli/pkg/cmdutil"
 )
@@ -21,6 +23,8 @@ func NewEntriesCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(browse.NewBrowseCmd(f, nil))
 	cmd.AddCommand(delete.NewDeleteCmd(f, nil))
 	cmd.AddCommand(importentries.NewImportCmd(f, nil))
+	cmd.AddCommand(export.NewExportCmd(f, nil))
+	cmd.AddCommand(diff.NewDiffCmd(f, nil))
 
 	return cmd
 }
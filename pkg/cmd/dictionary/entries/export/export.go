@@ -0,0 +1,213 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/dictionary/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+type ExportOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Dictionaries []search.DictionaryType
+	All          bool
+	Languages    []search.SupportedLanguage
+	Format       string
+	Directory    string
+}
+
+// NewExportCmd creates and returns an export command for dictionaries' entries.
+func NewExportCmd(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+	}
+
+	var languages []string
+
+	cmd := &cobra.Command{
+		Use:       "export {<dictionary>... | --all} [--language <language>...] [--format ndjson|wordlist] [-o <directory>]",
+		Args:      cobra.OnlyValidArgs,
+		ValidArgs: shared.DictionaryTypes(),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return shared.DictionaryTypes(), cobra.ShellCompDirectiveNoFileComp
+		},
+		Annotations: map[string]string{
+			"acls": "settings",
+		},
+		Short: "Export custom dictionary entries to files, one file per language",
+		Long: heredoc.Doc(`
+			Export the custom entries of dictionaries to a directory, with one file per dictionary and language,
+			named after them: stopwords-fr.ndjson, or stopwords-fr.txt with --format wordlist.
+
+			The ndjson files have one JSON entry per line, and the word lists one entry per line
+			(see "algolia dictionary entries import --help" for the format).
+			The entries are sorted by word, so that the files can be kept under version control.
+			Use "algolia dictionary entries diff" to compare the files with the entries of the application.
+		`),
+		Example: heredoc.Doc(`
+			# Export the custom entries of the "stopwords" dictionary to the current directory
+			$ algolia dictionary entries export stopwords
+
+			# Export the French custom entries of all the dictionaries as word lists to the "dictionaries" directory
+			$ algolia dictionary entries export --all --language fr --format wordlist -o dictionaries/
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.All && len(args) > 0 || !opts.All && len(args) == 0 {
+				return cmdutil.FlagErrorf(
+					"Either specify dictionaries' names or use --all to export all dictionaries",
+				)
+			}
+
+			if opts.All {
+				opts.Dictionaries = search.AllowedDictionaryTypeEnumValues
+			} else {
+				opts.Dictionaries = make([]search.DictionaryType, len(args))
+				for i, dict := range args {
+					opts.Dictionaries[i] = search.DictionaryType(dict)
+				}
+			}
+
+			for _, language := range languages {
+				l, err := search.NewSupportedLanguageFromValue(language)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid language %q", language)
+				}
+				opts.Languages = append(opts.Languages, *l)
+			}
+
+			format, err := cmdutil.FileFormat(opts.Format, "", "ndjson", "wordlist")
+			if err != nil {
+				return err
+			}
+			opts.Format = format
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runExportCmd(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Export all dictionaries")
+	cmd.Flags().
+		StringSliceVarP(&languages, "language", "l", nil, "Only export the entries of these languages (default: all the languages with custom entries)")
+	cmd.Flags().
+		StringVar(&opts.Format, "format", "ndjson", "Format of the files: ndjson or wordlist")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"ndjson":   "one JSON entry per line",
+		"wordlist": "one word, or comma-separated words, per line",
+	}))
+	cmd.Flags().
+		StringVarP(&opts.Directory, "output-dir", "o", ".", "Directory to write the files to")
+	_ = cmd.MarkFlagDirname("output-dir")
+
+	return cmd
+}
+
+// runExportCmd executes the export command
+func runExportCmd(opts *ExportOptions) error {
+	cs := opts.IO.ColorScheme()
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.Directory, 0o750); err != nil {
+		return err
+	}
+
+	for _, dictionary := range opts.Dictionaries {
+		opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Fetching the entries of %s", dictionary))
+		var language search.SupportedLanguage
+		if len(opts.Languages) == 1 {
+			language = opts.Languages[0]
+		}
+		entries, err := shared.CustomEntries(client, dictionary, language)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		byLanguage := shared.GroupByLanguage(entries, opts.Languages)
+		if len(byLanguage) == 0 && opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s No custom entries in %s\n", cs.WarningIcon(), dictionary)
+		}
+
+		for _, language := range shared.SortedLanguages(byLanguage) {
+			content, skipped, err := encodeEntries(dictionary, byLanguage[language], opts.Format)
+			if err != nil {
+				return err
+			}
+			for _, err := range skipped {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Skipped: %s\n", cs.WarningIcon(), err)
+			}
+
+			path := filepath.Join(opts.Directory, shared.EntriesFileName(dictionary, language, opts.Format))
+			if err := os.WriteFile(path, content, 0o644); err != nil { // nolint:gosec
+				return err
+			}
+
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(
+					opts.IO.Out,
+					"%s Exported %d entries to %s\n",
+					cs.SuccessIcon(),
+					len(byLanguage[language])-len(skipped),
+					path,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeEntries encodes the entries of a dictionary in a format.
+// The entries that can't be represented in the format are skipped, with the reason why.
+func encodeEntries(
+	dictionary search.DictionaryType,
+	entries []search.DictionaryEntry,
+	format string,
+) ([]byte, []error, error) {
+	var skipped []error
+	buf := bytes.Buffer{}
+	for _, entry := range entries {
+		if format == "wordlist" {
+			line, err := shared.FormatWordListEntry(dictionary, entry)
+			if err != nil {
+				skipped = append(skipped, err)
+				continue
+			}
+			buf.WriteString(line)
+			buf.WriteByte('\n')
+			continue
+		}
+
+		// All the exported entries are custom entries
+		entry.Type = nil
+		b, err := json.Marshal(entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), skipped, nil
+}
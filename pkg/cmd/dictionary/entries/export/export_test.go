@@ -0,0 +1,111 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const entriesResponse = `{"hits":[
+	{"objectID":"2","language":"fr","words":["oeil","yeux"],"type":"custom"},
+	{"objectID":"1","language":"fr","words":["cheval","chevaux"],"type":"custom"},
+	{"objectID":"3","language":"en","words":["mouse","mice"],"type":"custom"},
+	{"objectID":"4","language":"fr","words":["bal","bals"],"type":"standard"}
+],"page":0,"nbHits":4,"nbPages":1}`
+
+func Test_runExportCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantFiles map[string]string
+		wantOut   string
+	}{
+		{
+			name: "ndjson, all languages",
+			cli:  "plurals",
+			wantFiles: map[string]string{
+				"plurals-en.ndjson": `{"language":"en","objectID":"3","words":["mouse","mice"]}` + "\n",
+				"plurals-fr.ndjson": `{"language":"fr","objectID":"1","words":["cheval","chevaux"]}` + "\n" +
+					`{"language":"fr","objectID":"2","words":["oeil","yeux"]}` + "\n",
+			},
+			wantOut: "✓ Exported 1 entries to DIR/plurals-en.ndjson\n✓ Exported 2 entries to DIR/plurals-fr.ndjson\n",
+		},
+		{
+			name: "word list, one language",
+			cli:  "plurals --language fr --format wordlist",
+			wantFiles: map[string]string{
+				"plurals-fr.txt": "cheval,chevaux\noeil,yeux\n",
+			},
+			wantOut: "✓ Exported 2 entries to DIR/plurals-fr.txt\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("POST", "1/dictionaries/plurals/search"),
+				httpmock.StringResponse(entriesResponse),
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(true, &r, nil, "")
+			cmd := NewExportCmd(f, nil)
+			out, err := test.Execute(cmd, tt.cli+" -o "+dir, out)
+			require.NoError(t, err)
+
+			files, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Len(t, files, len(tt.wantFiles))
+			for name, want := range tt.wantFiles {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, want, string(got))
+			}
+
+			assert.Equal(t, strings.ReplaceAll(tt.wantOut, "DIR", dir), out.String())
+		})
+	}
+}
+
+func Test_NewExportCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no dictionary",
+			cli:     "",
+			wantErr: "Either specify dictionaries' names or use --all to export all dictionaries",
+		},
+		{
+			name:    "invalid language",
+			cli:     "stopwords --language xx",
+			wantErr: `invalid language "xx"`,
+		},
+		{
+			name:    "invalid format",
+			cli:     "stopwords --format solr",
+			wantErr: `invalid format "solr", expected one of: ndjson, wordlist`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, "")
+			cmd := NewExportCmd(f, func(opts *ExportOptions) error { return nil })
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
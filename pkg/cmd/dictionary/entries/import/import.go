@@ -2,8 +2,10 @@ package importentries
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -29,6 +31,8 @@ type ImportOptions struct {
 	SearchClient func() (*search.APIClient, error)
 
 	DictionaryType search.DictionaryType
+	Language       search.SupportedLanguage
+	Replace        bool
 	Wait           bool
 
 	File    string
@@ -45,8 +49,10 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		SearchClient: f.SearchClient,
 	}
 
+	var format, language string
+
 	cmd := &cobra.Command{
-		Use:       "import <dictionary> -F <file> [--wait] [--continue-on-errors]",
+		Use:       "import <dictionary> -F <file> [--format wordlist --language <language>] [--replace] [--wait] [--continue-on-errors]",
 		Args:      validators.ExactArgs(1),
 		ValidArgs: shared.DictionaryTypes(),
 		Annotations: map[string]string{
@@ -57,6 +63,14 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 			Import dictionary entries from a file to the specified index.
 			
 			The file must contains one single JSON object per line (newline delimited JSON objects - ndjson format: https://ndjson.org/).
+
+			With --format wordlist, the file is a plain text list of words of the language of --language, with one entry per line:
+			a stop word for stopwords, the singular and its plurals separated by commas for plurals (cheval,chevaux),
+			and the compound word and its decomposition separated by commas for compounds (kopfschmerz,kopf,schmerz).
+			Empty lines and lines starting with # are skipped.
+			The objectIDs are generated from the first word of each line, so importing an updated list updates the entries.
+
+			With --replace, the custom entries of the language of --language that aren't in the file are deleted.
 		`),
 		Example: heredoc.Doc(`
 			# Import entries from the "entries.ndjson" file to the "stopwords" dictionary
@@ -64,6 +78,12 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 
 			# Import entries from the "entries.ndjson" file to the "plurals" dictionary and continue importing entries even if some entries are invalid
 			$ algolia dictionary import plurals -F entries.ndjson --continue-on-errors
+
+			# Import the French stop words of the "stopwords-fr.txt" word list to the "stopwords" dictionary
+			$ algolia dictionary import stopwords -F stopwords-fr.txt --format wordlist --language fr
+
+			# Replace the custom French plurals with the ones of the "plurals-fr.txt" word list
+			$ algolia dictionary import plurals -F plurals-fr.txt --format wordlist --language fr --replace
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			d, err := search.NewDictionaryTypeFromValue(args[0])
@@ -72,11 +92,32 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 			}
 			opts.DictionaryType = *d
 
-			scanner, err := cmdutil.ScanFile(opts.File, opts.IO.In)
+			fileFormat, err := cmdutil.FileFormat(format, opts.File, "ndjson", "wordlist")
+			if err != nil {
+				return err
+			}
+			if language != "" {
+				l, err := search.NewSupportedLanguageFromValue(language)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid language %q", language)
+				}
+				opts.Language = *l
+			}
+			if fileFormat == "wordlist" && opts.Language == "" {
+				return cmdutil.FlagErrorf("--language is required with --format wordlist")
+			}
+			if opts.Replace && opts.Language == "" {
+				return cmdutil.FlagErrorf("--language is required with --replace")
+			}
+
+			if fileFormat == "wordlist" {
+				opts.Scanner, err = scanWordList(opts.File, opts.IO.In, opts.DictionaryType, opts.Language)
+			} else {
+				opts.Scanner, err = cmdutil.ScanFile(opts.File, opts.IO.In)
+			}
 			if err != nil {
 				return err
 			}
-			opts.Scanner = scanner
 
 			if runF != nil {
 				return runF(opts)
@@ -89,6 +130,16 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 	cmd.Flags().
 		StringVarP(&opts.File, "file", "F", "", "Read entries to import from `file` (use \"-\" to read from standard input)")
 	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().
+		StringVar(&format, "format", "", "Format of the file: ndjson or wordlist (default: ndjson)")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"ndjson":   "one JSON entry per line",
+		"wordlist": "one word, or comma-separated words, per line",
+	}))
+	cmd.Flags().
+		StringVarP(&language, "language", "l", "", "Language of the word list, and of the custom entries to replace with --replace")
+	cmd.Flags().
+		BoolVar(&opts.Replace, "replace", false, "Delete the custom entries of the language of --language that aren't in the file")
 
 	cmd.Flags().
 		BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete before returning")
@@ -134,9 +185,6 @@ func runImportCmd(opts *ImportOptions) error {
 			continue
 		}
 
-		fmt.Printf("TYPE: %v\n", opts.DictionaryType)
-		fmt.Printf("ENTRY: %v\n", entry)
-
 		dictionaryEntry, err := createDictionaryEntry(opts.DictionaryType, entry)
 		if err != nil {
 			errors = append(errors, fmt.Errorf("line %d: %s", currentLine, err.Error()).Error())
@@ -190,6 +238,34 @@ func runImportCmd(opts *ImportOptions) error {
 	)
 
 	var requests []search.BatchDictionaryEntriesRequest
+	deleted := 0
+	if opts.Replace {
+		opts.IO.UpdateProgressIndicatorLabel(
+			fmt.Sprintf("Fetching the %s entries of %s", opts.Language, cs.Bold(string(opts.DictionaryType))),
+		)
+		existing, err := shared.CustomEntries(client, opts.DictionaryType, opts.Language)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		imported := make(map[string]bool, len(entries))
+		for _, e := range entries {
+			imported[e.ObjectID] = true
+		}
+		for _, e := range existing {
+			if imported[e.ObjectID] {
+				continue
+			}
+			requests = append(
+				requests,
+				*search.NewBatchDictionaryEntriesRequest(
+					search.DICTIONARY_ACTION_DELETE_ENTRY,
+					*search.NewDictionaryEntry(e.ObjectID),
+				),
+			)
+			deleted++
+		}
+	}
 	for _, e := range entries {
 		requests = append(
 			requests,
@@ -217,6 +293,19 @@ func runImportCmd(opts *ImportOptions) error {
 	}
 
 	opts.IO.StopProgressIndicator()
+	if opts.Replace {
+		_, err = fmt.Fprintf(
+			opts.IO.Out,
+			"%s Successfully imported %s entries on %s and deleted %s other %s entries in %v\n",
+			cs.SuccessIcon(),
+			cs.Bold(fmt.Sprint(len(entries))),
+			cs.Bold(string(opts.DictionaryType)),
+			cs.Bold(fmt.Sprint(deleted)),
+			opts.Language,
+			time.Since(elapsed),
+		)
+		return err
+	}
 	_, err = fmt.Fprintf(
 		opts.IO.Out,
 		"%s Successfully imported %s entries on %s in %v\n",
@@ -228,6 +317,33 @@ func runImportCmd(opts *ImportOptions) error {
 	return err
 }
 
+// scanWordList reads a word list and returns a scanner over its entries, one JSON entry per line.
+func scanWordList(
+	filename string,
+	stdin io.ReadCloser,
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+) (*bufio.Scanner, error) {
+	b, err := cmdutil.ReadFile(filename, stdin)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := shared.ParseWordList(bytes.NewReader(b), dictionary, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse word list: %w", err)
+	}
+
+	items := make([]json.RawMessage, 0, len(entries))
+	for _, entry := range entries {
+		b, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, b)
+	}
+	return cmdutil.NewNDJSONScanner(items), nil
+}
+
 func createDictionaryEntry(
 	dictionaryType search.DictionaryType,
 	entry search.DictionaryEntry,
@@ -1,7 +1,9 @@
 package importentries
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
@@ -104,3 +106,96 @@ func Test_runImportCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runImportCmd_wordlist(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		stdin    string
+		existing string
+		wantBody func(t *testing.T, requests []search.BatchDictionaryEntriesRequest)
+		wantOut  string
+		wantErr  string
+	}{
+		{
+			name:  "stopwords",
+			cli:   "stopwords -F - --format wordlist --language fr",
+			stdin: "# articles\nle\nla\n",
+			wantBody: func(t *testing.T, requests []search.BatchDictionaryEntriesRequest) {
+				require.Len(t, requests, 2)
+				assert.Equal(t, search.DICTIONARY_ACTION_ADD_ENTRY, requests[0].Action)
+				assert.Equal(t, "le", requests[0].Body.GetWord())
+				assert.Equal(t, search.SUPPORTED_LANGUAGE_FR, requests[0].Body.GetLanguage())
+				assert.Equal(t, "la", requests[1].Body.GetWord())
+			},
+			wantOut: "✓ Successfully imported 2 entries on stopwords in",
+		},
+		{
+			name:     "replace the entries of the language",
+			cli:      "stopwords -F - --format wordlist --language fr --replace",
+			stdin:    "le\n",
+			existing: `{"hits":[{"objectID":"old","language":"fr","word":"du","type":"custom"},{"objectID":"default","language":"fr","word":"de","type":"standard"}],"page":0,"nbHits":2,"nbPages":1}`,
+			wantBody: func(t *testing.T, requests []search.BatchDictionaryEntriesRequest) {
+				require.Len(t, requests, 2)
+				assert.Equal(t, search.DICTIONARY_ACTION_DELETE_ENTRY, requests[0].Action)
+				assert.Equal(t, "old", requests[0].Body.ObjectID)
+				assert.Equal(t, search.DICTIONARY_ACTION_ADD_ENTRY, requests[1].Action)
+				assert.Equal(t, "le", requests[1].Body.GetWord())
+			},
+			wantOut: "✓ Successfully imported 1 entries on stopwords and deleted 1 other fr entries in",
+		},
+		{
+			name:    "missing language",
+			cli:     "plurals -F - --format wordlist",
+			stdin:   "cheval,chevaux\n",
+			wantErr: "--language is required with --format wordlist",
+		},
+		{
+			name:    "replace without language",
+			cli:     "plurals -F - --replace",
+			stdin:   `{"language":"fr","words":["cheval","chevaux"],"objectID":"cheval"}`,
+			wantErr: "--language is required with --replace",
+		},
+		{
+			name:    "invalid word list",
+			cli:     "plurals -F - --format wordlist --language fr",
+			stdin:   "cheval\n",
+			wantErr: "failed to parse word list: line 1: plurals require a singular and at least one plural",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.existing != "" {
+				r.Register(
+					httpmock.REST("POST", "1/dictionaries/stopwords/search"),
+					httpmock.StringResponse(tt.existing),
+				)
+			}
+			if tt.wantBody != nil {
+				r.Register(
+					httpmock.REST("POST", "1/dictionaries/stopwords/batch"),
+					func(req *http.Request) (*http.Response, error) {
+						var params search.BatchDictionaryEntriesParams
+						require.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+						assert.False(t, params.GetClearExistingDictionaryEntries())
+						tt.wantBody(t, params.Requests)
+						return httpmock.JSONResponse(search.UpdatedAtResponse{})(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(true, &r, nil, tt.stdin)
+			cmd := NewImportCmd(f, nil)
+			out, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+		})
+	}
+}
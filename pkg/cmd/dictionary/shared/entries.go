@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// BrowseEntries calls fn for each entry of a dictionary, including the standard entries of Algolia.
+// If language isn't empty, only the entries of this language are browsed.
+func BrowseEntries(
+	client *search.APIClient,
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+	fn func(entry search.DictionaryEntry) error,
+) error {
+	var page int32 = 0
+	var nbPages int32 = 1
+
+	// Infinite pagination
+	for page < nbPages {
+		params := search.NewEmptySearchDictionaryEntriesParams().
+			SetHitsPerPage(1000).
+			SetPage(page).
+			SetQuery("")
+		if language != "" {
+			params.SetLanguage(language)
+		}
+		res, err := client.SearchDictionaryEntries(
+			client.NewApiSearchDictionaryEntriesRequest(dictionary, params),
+		)
+		if err != nil {
+			return err
+		}
+		nbPages = res.NbPages
+
+		for _, entry := range res.Hits {
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+		page++
+	}
+
+	return nil
+}
+
+// CustomEntries returns all the custom entries of a dictionary.
+// If language isn't empty, only the entries of this language are returned.
+func CustomEntries(
+	client *search.APIClient,
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+) ([]search.DictionaryEntry, error) {
+	var entries []search.DictionaryEntry
+	err := BrowseEntries(client, dictionary, language, func(entry search.DictionaryEntry) error {
+		if IsCustom(entry) {
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// IsCustom returns true if the entry was added to the dictionary, instead of being a standard entry of Algolia.
+func IsCustom(entry search.DictionaryEntry) bool {
+	return entry.Type != nil && *entry.Type == search.DICTIONARY_ENTRY_TYPE_CUSTOM
+}
+
+// EntryKey identifies an entry by its content: its (first) word, regardless of the case.
+// Entries of a word list and entries imported from another format can be compared with it,
+// since their objectIDs are different.
+func EntryKey(entry search.DictionaryEntry) string {
+	if entry.Word != nil {
+		return strings.ToLower(*entry.Word)
+	}
+	if len(entry.Words) > 0 {
+		return strings.ToLower(entry.Words[0])
+	}
+	return ""
+}
+
+// GroupByLanguage groups the entries by language, sorted by word.
+// If languages isn't empty, only these languages are kept, even if they don't have entries.
+func GroupByLanguage(
+	entries []search.DictionaryEntry,
+	languages []search.SupportedLanguage,
+) map[search.SupportedLanguage][]search.DictionaryEntry {
+	byLanguage := map[search.SupportedLanguage][]search.DictionaryEntry{}
+	for _, l := range languages {
+		byLanguage[l] = []search.DictionaryEntry{}
+	}
+	for _, entry := range entries {
+		language := entry.GetLanguage()
+		if _, ok := byLanguage[language]; !ok && len(languages) > 0 {
+			continue
+		}
+		byLanguage[language] = append(byLanguage[language], entry)
+	}
+
+	for _, entries := range byLanguage {
+		sort.SliceStable(entries, func(i, j int) bool {
+			ki, kj := EntryKey(entries[i]), EntryKey(entries[j])
+			if ki != kj {
+				return ki < kj
+			}
+			return entries[i].ObjectID < entries[j].ObjectID
+		})
+	}
+	return byLanguage
+}
+
+// SortedLanguages returns the languages of entries grouped by GroupByLanguage, in alphabetical order.
+func SortedLanguages(
+	byLanguage map[search.SupportedLanguage][]search.DictionaryEntry,
+) []search.SupportedLanguage {
+	languages := make([]search.SupportedLanguage, 0, len(byLanguage))
+	for l := range byLanguage {
+		languages = append(languages, l)
+	}
+	sort.Slice(languages, func(i, j int) bool { return languages[i] < languages[j] })
+	return languages
+}
@@ -0,0 +1,94 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// EntriesFileFormats are the formats of the files of dictionary entries, with their extension.
+var EntriesFileFormats = map[string]string{
+	"ndjson":   ".ndjson",
+	"wordlist": ".txt",
+}
+
+// EntriesFileName returns the name of the file with the entries of a language of a dictionary,
+// like "stopwords-fr.txt".
+func EntriesFileName(
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+	format string,
+) string {
+	return fmt.Sprintf("%s-%s%s", dictionary, language, EntriesFileFormats[format])
+}
+
+// ParseEntriesFileName returns the dictionary, the language and the format of a file named by EntriesFileName.
+func ParseEntriesFileName(
+	name string,
+) (dictionary search.DictionaryType, language search.SupportedLanguage, format string, ok bool) {
+	ext := filepath.Ext(name)
+	for f, e := range EntriesFileFormats {
+		if e == ext {
+			format = f
+		}
+	}
+	if format == "" {
+		return "", "", "", false
+	}
+
+	// Dictionary names don't have dashes, but languages can (pt-br)
+	d, l, found := strings.Cut(strings.TrimSuffix(name, ext), "-")
+	if !found {
+		return "", "", "", false
+	}
+	dict, err := search.NewDictionaryTypeFromValue(d)
+	if err != nil {
+		return "", "", "", false
+	}
+	lang, err := search.NewSupportedLanguageFromValue(l)
+	if err != nil {
+		return "", "", "", false
+	}
+	return *dict, *lang, format, true
+}
+
+// ReadEntriesFile reads the entries of a file named by EntriesFileName.
+func ReadEntriesFile(
+	path string,
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+	format string,
+) ([]search.DictionaryEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "wordlist" {
+		entries, err := ParseWordList(bytes.NewReader(b), dictionary, language)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return entries, nil
+	}
+
+	var entries []search.DictionaryEntry
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var entry search.DictionaryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("%s: line %d: %w", path, line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,23 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseEntriesFileName(t *testing.T) {
+	dictionary, language, format, ok := ParseEntriesFileName(
+		EntriesFileName(search.DICTIONARY_TYPE_COMPOUNDS, search.SUPPORTED_LANGUAGE_PT_BR, "wordlist"),
+	)
+	assert.True(t, ok)
+	assert.Equal(t, search.DICTIONARY_TYPE_COMPOUNDS, dictionary)
+	assert.Equal(t, search.SUPPORTED_LANGUAGE_PT_BR, language)
+	assert.Equal(t, "wordlist", format)
+
+	for _, name := range []string{"stopwords-fr.json", "stopwords.ndjson", "synonyms-fr.txt", "stopwords-xx.txt"} {
+		_, _, _, ok := ParseEntriesFileName(name)
+		assert.False(t, ok, name)
+	}
+}
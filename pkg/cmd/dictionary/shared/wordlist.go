@@ -0,0 +1,135 @@
+package shared
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// WordListObjectIDPrefix is the prefix of the objectIDs of the entries imported from a word list.
+const WordListObjectIDPrefix = "wordlist-"
+
+// ParseWordList parses a plain text word list, with one entry per line:
+//
+//   - stopwords: a single word
+//   - plurals: the singular and its plurals, separated by commas (`cheval,chevaux`)
+//   - compounds: the compound word and its decomposition, separated by commas (`kopfschmerz,kopf,schmerz`)
+//
+// Empty lines and lines starting with `#` are skipped.
+// The objectIDs are generated from the language and the first word of the entry,
+// so importing an updated list updates the entries instead of duplicating them.
+func ParseWordList(
+	r io.Reader,
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+) ([]search.DictionaryEntry, error) {
+	var entries []search.DictionaryEntry
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var words []string
+		for _, w := range strings.Split(text, ",") {
+			w = strings.Join(strings.Fields(w), " ")
+			if w == "" {
+				return nil, fmt.Errorf("line %d: empty word in %q", line, text)
+			}
+			words = append(words, w)
+		}
+
+		objectID := wordListObjectID(dictionary, language, words[0])
+		switch dictionary {
+		case search.DICTIONARY_TYPE_STOPWORDS:
+			if len(words) > 1 {
+				return nil, fmt.Errorf("line %d: stopwords must have a single word per line", line)
+			}
+			entries = append(entries, *search.NewDictionaryEntry(
+				objectID,
+				search.WithDictionaryEntryLanguage(language),
+				search.WithDictionaryEntryWord(words[0]),
+			))
+		case search.DICTIONARY_TYPE_PLURALS:
+			if len(words) < 2 {
+				return nil, fmt.Errorf("line %d: plurals require a singular and at least one plural", line)
+			}
+			entries = append(entries, *search.NewDictionaryEntry(
+				objectID,
+				search.WithDictionaryEntryLanguage(language),
+				search.WithDictionaryEntryWords(words),
+			))
+		case search.DICTIONARY_TYPE_COMPOUNDS:
+			if len(words) < 3 {
+				return nil, fmt.Errorf(
+					"line %d: compounds require a word and at least two words in its decomposition",
+					line,
+				)
+			}
+			entries = append(entries, *search.NewDictionaryEntry(
+				objectID,
+				search.WithDictionaryEntryLanguage(language),
+				search.WithDictionaryEntryWord(words[0]),
+				search.WithDictionaryEntryDecomposition(words[1:]),
+			))
+		default:
+			return nil, fmt.Errorf("wrong dictionary name")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// wordListObjectID generates an objectID from the first word of an entry.
+func wordListObjectID(
+	dictionary search.DictionaryType,
+	language search.SupportedLanguage,
+	word string,
+) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s", dictionary, strings.ToLower(word))
+	return fmt.Sprintf("%s%s-%s", WordListObjectIDPrefix, language, hex.EncodeToString(h.Sum(nil))[:12])
+}
+
+// FormatWordListEntry formats an entry as a line of a word list.
+// Disabled entries and words with commas can't be represented in this format.
+func FormatWordListEntry(dictionary search.DictionaryType, entry search.DictionaryEntry) (string, error) {
+	if entry.State != nil && *entry.State == search.DICTIONARY_ENTRY_STATE_DISABLED {
+		return "", fmt.Errorf("entry %q is disabled, which can't be represented in a word list", entry.ObjectID)
+	}
+
+	words := EntryWords(dictionary, entry)
+	if len(words) == 0 || strings.HasPrefix(words[0], "#") {
+		return "", fmt.Errorf("entry %q has words that can't be represented in a word list", entry.ObjectID)
+	}
+	for _, w := range words {
+		if w == "" || strings.Contains(w, ",") {
+			return "", fmt.Errorf("entry %q has words that can't be represented in a word list", entry.ObjectID)
+		}
+	}
+	return strings.Join(words, ","), nil
+}
+
+// EntryWords returns the words of an entry, in the order of a line of a word list:
+// the stop word, the singular and its plurals, or the compound word and its decomposition.
+func EntryWords(dictionary search.DictionaryType, entry search.DictionaryEntry) []string {
+	switch dictionary {
+	case search.DICTIONARY_TYPE_STOPWORDS:
+		return []string{entry.GetWord()}
+	case search.DICTIONARY_TYPE_PLURALS:
+		return entry.Words
+	case search.DICTIONARY_TYPE_COMPOUNDS:
+		return append([]string{entry.GetWord()}, entry.Decomposition...)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseWordList(t *testing.T) {
+	fr := search.SUPPORTED_LANGUAGE_FR
+
+	tests := []struct {
+		name       string
+		dictionary search.DictionaryType
+		file       string
+		want       []search.DictionaryEntry
+		wantErr    string
+	}{
+		{
+			name:       "stopwords",
+			dictionary: search.DICTIONARY_TYPE_STOPWORDS,
+			file:       "# articles\nle\n\n  la  \n",
+			want: []search.DictionaryEntry{
+				*search.NewDictionaryEntry("", search.WithDictionaryEntryLanguage(fr), search.WithDictionaryEntryWord("le")),
+				*search.NewDictionaryEntry("", search.WithDictionaryEntryLanguage(fr), search.WithDictionaryEntryWord("la")),
+			},
+		},
+		{
+			name:       "plurals",
+			dictionary: search.DICTIONARY_TYPE_PLURALS,
+			file:       "cheval, chevaux\noeil,yeux,oeils\n",
+			want: []search.DictionaryEntry{
+				*search.NewDictionaryEntry(
+					"",
+					search.WithDictionaryEntryLanguage(fr),
+					search.WithDictionaryEntryWords([]string{"cheval", "chevaux"}),
+				),
+				*search.NewDictionaryEntry(
+					"",
+					search.WithDictionaryEntryLanguage(fr),
+					search.WithDictionaryEntryWords([]string{"oeil", "yeux", "oeils"}),
+				),
+			},
+		},
+		{
+			name:       "compounds",
+			dictionary: search.DICTIONARY_TYPE_COMPOUNDS,
+			file:       "portefeuille,porte,feuille\n",
+			want: []search.DictionaryEntry{
+				*search.NewDictionaryEntry(
+					"",
+					search.WithDictionaryEntryLanguage(fr),
+					search.WithDictionaryEntryWord("portefeuille"),
+					search.WithDictionaryEntryDecomposition([]string{"porte", "feuille"}),
+				),
+			},
+		},
+		{
+			name:       "several stopwords on a line",
+			dictionary: search.DICTIONARY_TYPE_STOPWORDS,
+			file:       "le\nla,les\n",
+			wantErr:    "line 2: stopwords must have a single word per line",
+		},
+		{
+			name:       "plural without plurals",
+			dictionary: search.DICTIONARY_TYPE_PLURALS,
+			file:       "cheval\n",
+			wantErr:    "line 1: plurals require a singular and at least one plural",
+		},
+		{
+			name:       "compound without decomposition",
+			dictionary: search.DICTIONARY_TYPE_COMPOUNDS,
+			file:       "portefeuille,porte\n",
+			wantErr:    "line 1: compounds require a word and at least two words in its decomposition",
+		},
+		{
+			name:       "empty word",
+			dictionary: search.DICTIONARY_TYPE_PLURALS,
+			file:       "cheval,,chevaux\n",
+			wantErr:    `line 1: empty word in "cheval,,chevaux"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := ParseWordList(strings.NewReader(tt.file), tt.dictionary, fr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, entries, len(tt.want))
+
+			for i, entry := range entries {
+				assert.True(t, strings.HasPrefix(entry.ObjectID, WordListObjectIDPrefix+"fr-"))
+				entry.ObjectID = ""
+				assert.Equal(t, tt.want[i], entry)
+			}
+		})
+	}
+}
+
+func Test_ParseWordList_stableObjectIDs(t *testing.T) {
+	first, err := ParseWordList(
+		strings.NewReader("cheval,chevaux\noeil,yeux"),
+		search.DICTIONARY_TYPE_PLURALS,
+		search.SUPPORTED_LANGUAGE_FR,
+	)
+	require.NoError(t, err)
+	second, err := ParseWordList(
+		strings.NewReader("Cheval,chevaux,chevals\n"),
+		search.DICTIONARY_TYPE_PLURALS,
+		search.SUPPORTED_LANGUAGE_FR,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, first[0].ObjectID, second[0].ObjectID)
+	assert.NotEqual(t, first[0].ObjectID, first[1].ObjectID)
+}
@@ -3,10 +3,13 @@ package analyze
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
 	"github.com/algolia/cli/internal/analyze"
@@ -27,6 +30,9 @@ type StatsOptions struct {
 	BrowseParams search.BrowseParamsObject
 	NoLimit      bool
 	Only         string
+	Suggest      bool
+	Schema       *analyze.Schema
+	Threshold    float64
 
 	PrintFlags *cmdutil.PrintFlags
 }
@@ -40,6 +46,8 @@ func NewAnalyzeCmd(f *cmdutil.Factory) *cobra.Command {
 		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
+	var schemaFile string
+
 	cmd := &cobra.Command{
 		Use:               "analyze <index>",
 		Args:              validators.ExactArgs(1),
@@ -52,9 +60,23 @@ func NewAnalyzeCmd(f *cmdutil.Factory) *cobra.Command {
 			This command displays records statistics - frequency of the attributes and their types - for the specified index.
 			This can be useful to help you identify individual records (or attributes) within an index that do not conform to the rest of the dataset (e.g. numeric attributes that have null values).
 
+			For numeric attributes, the command also displays the minimum, maximum, mean, median (p50) and 95th percentile (p95) of the values.
+			For string attributes, it displays length statistics and the number of distinct values (cardinality).
+			It also displays a histogram of the serialized size of the records, along with the largest records by objectID.
+
 			Per default, the command will only analyze the first 1000 records. You can use the "--no-limit" flag to analyze all the records (this might take a while, depending on the number of records in your index).
+			Records are analyzed as they are fetched, so that memory usage stays bounded on large indices: above 10,000 values, percentiles are computed on a random sample and cardinalities are estimated.
+
+			You can also use the "--only" flag to only analyze a specific attribute. In this case, the command will display the frequency of the values for this attribute (only the 10,000 most frequent values are tracked).
 
-			You can also use the "--only" flag to only analyze a specific attribute. In this case, the command will display the frequency of the values for this attribute.
+			With the "--suggest" flag, the command proposes settings changes based on the analyzed records: low-cardinality string attributes to add to "attributesForFaceting", numeric attributes to add to "customRanking", and searchable attributes missing from most records.
+			It also flags the attributes used in the settings that don't exist in any of the analyzed records.
+			The suggested changes are printed as a settings patch that can be applied with "algolia settings import".
+
+			With the "--against" flag, the command compares the records with an expected schema instead, and reports new attributes, missing required attributes and type mismatches, with example objectIDs.
+			The schema can be a JSON Schema, or a simple map of attribute names to types, like {"title": "string", "year": ["numeric", "null"], "meta.rating?": "numeric"}, where attributes ending with "?" are optional.
+			Each drift is reported with the percentage of all the analyzed records it affects, including for nested attributes.
+			The command exits with a non-zero status if a drift affects more than "--threshold" percent of the records.
 		`),
 		Example: heredoc.Doc(`
 			# Display records statistics for the "MOVIES" index for the first 1000 records
@@ -68,10 +90,37 @@ func NewAnalyzeCmd(f *cmdutil.Factory) *cobra.Command {
 
 			# Display records statistics for the "MOVIES" index with the "actors" attribute only
 			$ algolia index analyze MOVIES --only actors
+
+			# Suggest settings changes for the "MOVIES" index and apply them
+			$ algolia index analyze MOVIES --no-limit --suggest > patch.json
+			$ algolia settings import MOVIES -F patch.json
+
+			# Check the records of the "MOVIES" index against the schema in "schema.json", allowing each drift to affect up to 1% of the records
+			$ algolia index analyze MOVIES --no-limit --against schema.json --threshold 1
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
 
+			if err := cmdutil.MutuallyExclusive(
+				"`--only`, `--suggest` and `--against` are mutually exclusive",
+				opts.Only != "",
+				opts.Suggest,
+				schemaFile != "",
+			); err != nil {
+				return err
+			}
+
+			if schemaFile != "" {
+				b, err := cmdutil.ReadFile(schemaFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Schema, err = analyze.ParseSchema(b)
+				if err != nil {
+					return err
+				}
+			}
+
 			browseParamsMap, err := cmdutil.FlagValuesMap(
 				cmd.Flags(),
 				cmdutil.BrowseParamsObject...)
@@ -97,6 +146,12 @@ func NewAnalyzeCmd(f *cmdutil.Factory) *cobra.Command {
 		BoolVarP(&opts.NoLimit, "no-limit", "n", false, "If set, the command will not limit the number of objects to analyze. Otherwise, the default limit is 1000 objects.")
 	cmd.Flags().
 		StringVarP(&opts.Only, "only", "", "", "If set, the command will only analyze the specified attribute. Chosen attribute values statistics will be shown in the output.")
+	cmd.Flags().
+		BoolVar(&opts.Suggest, "suggest", false, "If set, the command will print suggested settings changes as a settings patch instead of the statistics.")
+	cmd.Flags().
+		StringVar(&schemaFile, "against", "", "Compare the records with the expected schema in a `file` (use \"-\" to read from standard input).")
+	cmd.Flags().
+		Float64Var(&opts.Threshold, "threshold", 0, "With --against, the maximum percentage of records a drift can affect before exiting with a non-zero status.")
 
 	cmdutil.AddBrowseParamsObjectFlags(cmd)
 	opts.PrintFlags.AddFlags(cmd)
@@ -111,21 +166,45 @@ func runAnalyzeCmd(opts *StatsOptions) error {
 		return err
 	}
 
-	var records []search.Hit
-	counter := 1
+	settings, err := client.GetSettings(client.NewApiGetSettingsRequest(opts.Index))
+	if err != nil {
+		return err
+	}
+
+	// Records are added to the stats one at a time, so that they don't have to be kept in memory
+	accumulator := analyze.NewAccumulator(*settings, opts.Only)
+	if opts.Schema != nil {
+		accumulator.TrackMissing(opts.Schema.Required...)
+	}
+	counter := 0
+	var addErr error
+	addHits := func(hits []search.Hit, limit int) {
+		for _, hit := range hits {
+			if addErr != nil {
+				return
+			}
+			addErr = accumulator.Add(hit)
+			counter++
+			io.UpdateProgressIndicatorLabel(progressLabel(counter, limit))
+		}
+	}
 
 	// With `--no-limit`, we iterate over all records
 	if opts.NoLimit {
-		// Get the number of records (just for printing; maybe we can avoid this?)
+		// Get the number of records to display the progress, with the same filters as the browse
+		countParams, err := countSearchParams(opts.BrowseParams)
+		if err != nil {
+			return err
+		}
 		res, err := client.SearchSingleIndex(
 			client.NewApiSearchSingleIndexRequest(opts.Index).
-				WithSearchParams(search.SearchParamsObjectAsSearchParams(search.NewEmptySearchParamsObject().SetQuery("").SetHitsPerPage(0))),
+				WithSearchParams(search.SearchParamsObjectAsSearchParams(countParams)),
 		)
 		if err != nil {
 			return err
 		}
-		limit := int(*res.NbHits)
-		io.StartProgressIndicatorWithLabel(fmt.Sprintf("Analyzing 0/%d objects", limit))
+		limit := int(res.GetNbHits())
+		io.StartProgressIndicatorWithLabel(progressLabel(0, limit))
 		err = client.BrowseObjects(
 			opts.Index,
 			opts.BrowseParams,
@@ -133,13 +212,7 @@ func runAnalyzeCmd(opts *StatsOptions) error {
 				if err != nil {
 					return
 				}
-				for _, hit := range response.(*search.BrowseResponse).Hits {
-					io.UpdateProgressIndicatorLabel(
-						fmt.Sprintf("Analyzing %d/%d objects", counter, limit),
-					)
-					records = append(records, hit)
-					counter++
-				}
+				addHits(response.(*search.BrowseResponse).Hits, limit)
 			}),
 		)
 		if err != nil {
@@ -154,34 +227,31 @@ func runAnalyzeCmd(opts *StatsOptions) error {
 				NewApiBrowseRequest(opts.Index).
 				WithBrowseParams(search.BrowseParamsObjectAsBrowseParams(&opts.BrowseParams)),
 		)
-		limit := int(*res.NbHits)
-		io.StartProgressIndicatorWithLabel(fmt.Sprintf("Analyzing 0/%d objects", limit))
 		if err != nil {
 			return err
 		}
-		for _, hit := range res.Hits {
-			io.UpdateProgressIndicatorLabel(
-				fmt.Sprintf("Analyzing %d/%d objects", counter, limit),
-			)
-			records = append(records, hit)
-			counter++
-		}
+		limit := len(res.Hits)
+		io.StartProgressIndicatorWithLabel(progressLabel(0, limit))
+		addHits(res.Hits, limit)
 	}
 
-	settings, err := client.GetSettings(client.NewApiGetSettingsRequest(opts.Index))
-	if err != nil {
+	if addErr != nil {
 		io.StopProgressIndicator()
-		return err
+		return addErr
 	}
 
-	stats, err := analyze.ComputeStats(records, *settings, opts.Only)
-	if err != nil {
-		io.StopProgressIndicator()
-		return err
-	}
+	stats := accumulator.Stats()
 
 	io.StopProgressIndicator()
 
+	if opts.Suggest {
+		return printSuggestions(analyze.Suggest(stats, *settings), opts)
+	}
+
+	if opts.Schema != nil {
+		return printDrifts(analyze.CheckSchema(stats, opts.Schema), opts)
+	}
+
 	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
 		p, err := opts.PrintFlags.ToPrinter()
 		if err != nil {
@@ -212,6 +282,15 @@ func runAnalyzeCmd(opts *StatsOptions) error {
 	return printStats(stats, opts)
 }
 
+// progressLabel returns the label of the progress indicator, with the percentage of analyzed records
+func progressLabel(counter int, limit int) string {
+	percentage := 100
+	if limit > 0 && counter < limit {
+		percentage = counter * 100 / limit
+	}
+	return fmt.Sprintf("Analyzing %d/%d objects (%d%%)", counter, limit, percentage)
+}
+
 // printStats prints the global stats for the index in a table format
 func printStats(stats *analyze.Stats, opts *StatsOptions) error {
 	cs := opts.IO.ColorScheme()
@@ -267,9 +346,259 @@ func printStats(stats *analyze.Stats, opts *StatsOptions) error {
 		table.EndRow()
 	}
 
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if err := printNumericStats(stats, sorted, opts); err != nil {
+		return err
+	}
+	if err := printStringStats(stats, sorted, opts); err != nil {
+		return err
+	}
+	return printSizeStats(stats, opts)
+}
+
+// printNumericStats prints the distribution of the numeric attributes in a table format
+func printNumericStats(stats *analyze.Stats, keys []string, opts *StatsOptions) error {
+	var numericKeys []string
+	for _, key := range keys {
+		if stats.Attributes[key].Numeric != nil {
+			numericKeys = append(numericKeys, key)
+		}
+	}
+	if len(numericKeys) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", opts.IO.ColorScheme().Bold("Numeric attributes"))
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("KEY", nil, nil)
+		table.AddField("MIN", nil, nil)
+		table.AddField("MAX", nil, nil)
+		table.AddField("MEAN", nil, nil)
+		table.AddField("P50", nil, nil)
+		table.AddField("P95", nil, nil)
+		table.EndRow()
+	}
+
+	for _, key := range numericKeys {
+		n := stats.Attributes[key].Numeric
+		table.AddField(key, nil, nil)
+		table.AddField(formatNumber(n.Min), nil, nil)
+		table.AddField(formatNumber(n.Max), nil, nil)
+		table.AddField(formatNumber(n.Mean), nil, nil)
+		table.AddField(formatNumber(n.P50), nil, nil)
+		table.AddField(formatNumber(n.P95), nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// printStringStats prints the length statistics and cardinality of the string attributes in a table format
+func printStringStats(stats *analyze.Stats, keys []string, opts *StatsOptions) error {
+	var stringKeys []string
+	for _, key := range keys {
+		if stats.Attributes[key].Strings != nil {
+			stringKeys = append(stringKeys, key)
+		}
+	}
+	if len(stringKeys) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", opts.IO.ColorScheme().Bold("String attributes"))
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("KEY", nil, nil)
+		table.AddField("MIN LENGTH", nil, nil)
+		table.AddField("MAX LENGTH", nil, nil)
+		table.AddField("MEAN LENGTH", nil, nil)
+		table.AddField("CARDINALITY", nil, nil)
+		table.EndRow()
+	}
+
+	for _, key := range stringKeys {
+		s := stats.Attributes[key].Strings
+		table.AddField(key, nil, nil)
+		table.AddField(fmt.Sprintf("%d", s.MinLength), nil, nil)
+		table.AddField(fmt.Sprintf("%d", s.MaxLength), nil, nil)
+		table.AddField(formatNumber(s.MeanLength), nil, nil)
+		table.AddField(fmt.Sprintf("%d", s.Cardinality), nil, nil)
+		table.EndRow()
+	}
+
 	return table.Render()
 }
 
+// printSizeStats prints the record size histogram and the largest records in a table format
+func printSizeStats(stats *analyze.Stats, opts *StatsOptions) error {
+	if stats.Sizes == nil {
+		return nil
+	}
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintf(
+		opts.IO.Out,
+		"\n%s (min: %s, max: %s, mean: %s, p50: %s, p95: %s)\n",
+		cs.Bold("Record sizes"),
+		humanize.Bytes(uint64(stats.Sizes.Min)),
+		humanize.Bytes(uint64(stats.Sizes.Max)),
+		humanize.Bytes(uint64(stats.Sizes.Mean)),
+		humanize.Bytes(uint64(stats.Sizes.P50)),
+		humanize.Bytes(uint64(stats.Sizes.P95)),
+	)
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("SIZE", nil, nil)
+		table.AddField("COUNT", nil, nil)
+		table.AddField("%", nil, nil)
+		table.EndRow()
+	}
+
+	for _, bucket := range stats.Sizes.Histogram {
+		var label string
+		if bucket.To == 0 {
+			label = fmt.Sprintf(">= %s", humanize.Bytes(uint64(bucket.From)))
+		} else {
+			label = fmt.Sprintf("%s - %s", humanize.Bytes(uint64(bucket.From)), humanize.Bytes(uint64(bucket.To)))
+		}
+		table.AddField(label, nil, nil)
+		table.AddField(fmt.Sprintf("%d", bucket.Count), nil, nil)
+		table.AddField(
+			fmt.Sprintf("%.2f%%", float64(bucket.Count)*100/float64(stats.TotalRecords)),
+			nil,
+			nil,
+		)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", cs.Bold("Largest records"))
+	table = printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("OBJECT ID", nil, nil)
+		table.AddField("SIZE", nil, nil)
+		table.EndRow()
+	}
+	for _, record := range stats.Sizes.Largest {
+		table.AddField(record.ObjectID, nil, nil)
+		table.AddField(humanize.Bytes(uint64(record.Size)), nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// printSuggestions prints the suggested settings patch to stdout and explains each suggestion on stderr
+func printSuggestions(suggestions *analyze.Suggestions, opts *StatsOptions) error {
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, suggestions)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if len(suggestions.Suggestions) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "%s No settings changes to suggest\n", cs.SuccessIcon())
+	}
+	for _, s := range suggestions.Suggestions {
+		var action string
+		switch s.Action {
+		case analyze.Add:
+			action = cs.Green(fmt.Sprintf("add %q to", s.Attribute))
+		case analyze.Remove:
+			action = cs.Red(fmt.Sprintf("remove %q from", s.Attribute))
+		case analyze.Unknown:
+			action = cs.Yellow(fmt.Sprintf("unknown attribute %q in", s.Attribute))
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s %s: %s\n", cs.Bold("*"), action, s.Setting, s.Reason)
+	}
+
+	p := &printers.JSONPrinter{}
+	return p.Print(opts.IO, suggestions.Patch)
+}
+
+// countSearchParams returns the search parameters to count the records matching the browse parameters.
+func countSearchParams(browseParams search.BrowseParamsObject) (*search.SearchParamsObject, error) {
+	// Convert the browse parameters to search parameters: they share the same fields, apart from the cursor
+	tmp, err := json.Marshal(browseParams)
+	if err != nil {
+		return nil, err
+	}
+	params := search.NewEmptySearchParamsObject()
+	if err := json.Unmarshal(tmp, params); err != nil {
+		return nil, err
+	}
+	if params.Query == nil {
+		params.SetQuery("")
+	}
+	return params.SetHitsPerPage(0), nil
+}
+
+// printDrifts prints the differences between the records and the expected schema in a table format.
+// It returns a silent error if a drift exceeds the threshold.
+func printDrifts(drifts []analyze.Drift, opts *StatsOptions) error {
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, drifts); err != nil {
+			return err
+		}
+	} else if len(drifts) > 0 {
+		cs := opts.IO.ColorScheme()
+		table := printers.NewTablePrinter(opts.IO)
+		if table.IsTTY() {
+			table.AddField("KIND", nil, nil)
+			table.AddField("ATTRIBUTE", nil, nil)
+			table.AddField("EXPECTED", nil, nil)
+			table.AddField("ACTUAL", nil, nil)
+			table.AddField("%", nil, nil)
+			table.AddField("EXAMPLES", nil, nil)
+			table.EndRow()
+		}
+
+		for _, drift := range drifts {
+			color := func(s string) string { return s }
+			if drift.Percentage > opts.Threshold {
+				color = cs.Red
+			}
+			table.AddField(color(string(drift.Kind)), nil, nil)
+			table.AddField(drift.Attribute, nil, nil)
+			table.AddField(fmt.Sprintf("%v", drift.Expected), nil, nil)
+			table.AddField(string(drift.Actual), nil, nil)
+			table.AddField(color(fmt.Sprintf("%.2f%%", drift.Percentage)), nil, nil)
+			table.AddField(fmt.Sprintf("%v", drift.Examples), nil, nil)
+			table.EndRow()
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	} else if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s The records match the schema\n", opts.IO.ColorScheme().SuccessIcon())
+	}
+
+	for _, drift := range drifts {
+		if drift.Percentage > opts.Threshold {
+			return cmdutil.ErrSilent
+		}
+	}
+	return nil
+}
+
+// formatNumber formats a number with at most two decimals and without trailing zeros
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+}
+
 // printSingleAttributeStats prints the stats for a single attribute in a table format
 func printSingleAttributeStats(stats *analyze.Stats, opts *StatsOptions) error {
 	table := printers.NewTablePrinter(opts.IO)
@@ -0,0 +1,54 @@
+package analyze
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runAnalyzeCmd_noLimitCount(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(httpmock.REST("GET", "1/indexes/MOVIES/settings"), httpmock.JSONResponse(map[string]any{}))
+	r.Register(
+		httpmock.REST("POST", "1/indexes/MOVIES/query"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			var params map[string]any
+			require.NoError(t, json.Unmarshal(body, &params))
+			// The count uses the same filters as the browse
+			assert.Equal(t, "year > 2000", params["filters"])
+			assert.Equal(t, float64(0), params["hitsPerPage"])
+			return httpmock.JSONResponse(map[string]any{
+				"hits":             []any{},
+				"nbHits":           1,
+				"processingTimeMS": 1,
+				"query":            "",
+				"params":           "",
+			})(req)
+		},
+	)
+	r.Register(
+		httpmock.REST("POST", "1/indexes/MOVIES/browse"),
+		httpmock.JSONResponse(map[string]any{
+			"hits":             []any{map[string]any{"objectID": "1", "title": "Heat", "year": 2001}},
+			"nbHits":           1,
+			"processingTimeMS": 1,
+			"query":            "",
+			"params":           "",
+		}),
+	)
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(false, &r, nil, "")
+	cmd := NewAnalyzeCmd(f)
+	_, err := test.Execute(cmd, `MOVIES --no-limit --filters "year > 2000"`, out)
+	require.NoError(t, err)
+}
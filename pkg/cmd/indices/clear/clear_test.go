@@ -102,6 +102,13 @@ func Test_runCreateCmd(t *testing.T) {
 			isTTY:   true,
 			wantOut: "✓ Cleared index foo\n",
 		},
+		{
+			name:    "JSON output",
+			cli:     "foo --confirm -o json",
+			index:   "foo",
+			isTTY:   true,
+			wantOut: "[{\"index\":\"foo\",\"taskID\":42}]\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -109,7 +116,7 @@ func Test_runCreateCmd(t *testing.T) {
 			r := httpmock.Registry{}
 			r.Register(
 				httpmock.REST("POST", fmt.Sprintf("1/indexes/%s/clear", tt.index)),
-				httpmock.JSONResponse(search.UpdatedAtResponse{}),
+				httpmock.JSONResponse(search.UpdatedAtResponse{TaskID: 42}),
 			)
 			defer r.Verify(t)
 
@@ -26,7 +26,7 @@ func NewExportCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:               "export <index> [--scope <scope>...] [--directory]",
+		Use:               "export <index> [--scope <scope>...] [--directory] [--format <format>]",
 		Args:              validators.ExactArgs(1),
 		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
 		Annotations: map[string]string{
@@ -45,10 +45,19 @@ func NewExportCmd(f *cmdutil.Factory) *cobra.Command {
 
 			# Export the config of the index 'MOVIES' to a .json file in the 'exports' folder
 			$ algolia index config export MOVIES --directory exports
+
+			# Export the config of the index 'MOVIES' to a .yaml file in the current folder
+			$ algolia index config export MOVIES --format yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
 
+			format, err := cmdutil.FileFormat(opts.Format, "", "json", "yaml")
+			if err != nil {
+				return err
+			}
+			opts.Format = format
+
 			client, err := opts.SearchClient()
 			if err != nil {
 				return err
@@ -80,6 +89,12 @@ func NewExportCmd(f *cmdutil.Factory) *cobra.Command {
 	_ = cmd.MarkFlagDirname("directory")
 	cmd.Flags().
 		StringSliceVarP(&opts.Scope, "scope", "s", []string{"settings", "synonyms", "rules"}, "Scope to export (default: all)")
+	cmd.Flags().
+		StringVar(&opts.Format, "format", "json", "Format of the output file: json or yaml")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"json": "JSON file",
+		"yaml": "YAML file",
+	}))
 	_ = cmd.RegisterFlagCompletionFunc("scope",
 		cmdutil.StringSliceCompletionFunc(map[string]string{
 			"settings": "settings",
@@ -102,11 +117,17 @@ func runExportCmd(opts *config.ExportOptions) error {
 		return err
 	}
 
-	configJSONIndented, err := json.MarshalIndent(configJSON, "", "  ")
+	var content []byte
+	if opts.Format == "yaml" {
+		content, err = utils.MarshalYAML(configJSON)
+	} else {
+		content, err = json.MarshalIndent(configJSON, "", "  ")
+	}
 	if err != nil {
 		return fmt.Errorf(
-			"%s An error occurred when creating the config json: %w",
+			"%s An error occurred when creating the config %s: %w",
 			cs.FailureIcon(),
+			opts.Format,
 			err,
 		)
 	}
@@ -115,9 +136,10 @@ func runExportCmd(opts *config.ExportOptions) error {
 		opts.Directory,
 		opts.Index,
 		client.GetConfiguration().AppID,
+		opts.Format,
 	)
 	// Gosec wants permissions of 0600 or less, but I don't want to change it
-	err = os.WriteFile(filePath, configJSONIndented, 0o644) // nolint:gosec
+	err = os.WriteFile(filePath, content, 0o644) // nolint:gosec
 	if err != nil {
 		return fmt.Errorf("%s An error occurred when saving the file: %w", cs.FailureIcon(), err)
 	}
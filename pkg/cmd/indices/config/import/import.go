@@ -40,6 +40,9 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 			# Import the config from a .json file into 'PROD_MOVIES' index
 			$ algolia index config import PROD_MOVIES -F export-STAGING_MOVIES-APP_ID-1666792448.json
 
+			# Import the config from a .yaml file into 'PROD_MOVIES' index
+			$ algolia index config import PROD_MOVIES -F export-STAGING_MOVIES-APP_ID-1666792448.yaml
+
 			# Import only the synonyms and settings from a .json file to the 'PROD_MOVIES' index
 			$ algolia index config import PROD_MOVIES -F export-STAGING_MOVIES-APP_ID-1666792448.json --scope synonyms, settings
 
@@ -98,7 +101,9 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().BoolVarP(&confirm, "confirm", "y", false, "Skip confirmation prompt")
 	// Options
 	cmd.Flags().
-		StringVarP(&opts.FilePath, "file", "F", "", "Directory path of the JSON config file")
+		StringVarP(&opts.FilePath, "file", "F", "", "Directory path of the JSON or YAML config file")
+	cmd.Flags().
+		StringVar(&opts.Format, "format", "", "Format of the config file: json or yaml (default: detected from the file extension, else json)")
 	cmd.Flags().
 		StringSliceVarP(&opts.Scope, "scope", "s", []string{}, "Scope to import (default: none)")
 	_ = cmd.RegisterFlagCompletionFunc("scope",
@@ -118,6 +123,8 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 		BoolVarP(&opts.ForwardRulesToReplicas, "forward-rules-to-replicas", "l", false, "Forward imported rules to replicas")
 	cmd.Flags().
 		BoolVarP(&opts.ForwardSettingsToReplicas, "forward-settings-to-replicas", "t", false, "Forward imported settings to replicas")
+	cmd.Flags().
+		BoolVar(&opts.SkipValidation, "skip-validation", false, "Import the settings without validating them first")
 
 	return cmd
 }
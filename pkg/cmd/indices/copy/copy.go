@@ -29,6 +29,8 @@ type CopyOptions struct {
 	Wait bool
 
 	DoConfirm bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewCopyCmd creates and returns a copy command for indices
@@ -37,6 +39,7 @@ func NewCopyCmd(f *cmdutil.Factory, runF func(*CopyOptions) error) *cobra.Comman
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	var confirm bool
@@ -101,6 +104,8 @@ func NewCopyCmd(f *cmdutil.Factory, runF func(*CopyOptions) error) *cobra.Comman
 			"rules":    "rules",
 		}, "copy"))
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -174,6 +179,10 @@ func runCopyCmd(opts *CopyOptions) error {
 
 	opts.IO.StopProgressIndicator()
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.DestinationIndex, TaskID: res.TaskID}})
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(
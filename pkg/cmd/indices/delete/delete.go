@@ -25,6 +25,8 @@ type DeleteOptions struct {
 	DoConfirm       bool
 	IncludeReplicas bool
 	Wait            bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewDeleteCmd creates and returns a delete command for indices
@@ -33,6 +35,7 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	var confirm bool
@@ -91,6 +94,8 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		BoolVarP(&opts.IncludeReplicas, "include-replicas", "r", false, "delete replica indices too")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "wait for the operation to complete")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -129,6 +134,7 @@ func runDeleteCmd(opts *DeleteOptions) error {
 		}
 	}
 
+	var tasks []cmdutil.Task
 	for _, index := range opts.Indices {
 		// Equivalent to `client.IndexExists` but provides settings already
 		settings, err := client.GetSettings(client.NewApiGetSettingsRequest(index))
@@ -167,6 +173,7 @@ func runDeleteCmd(opts *DeleteOptions) error {
 			opts.IO.StopProgressIndicator()
 			return fmt.Errorf("can't delete index %s: %w", index, err)
 		}
+		tasks = append(tasks, cmdutil.Task{Index: index, TaskID: res.TaskID})
 
 		if !opts.IncludeReplicas && opts.Wait {
 			opts.IO.UpdateProgressIndicatorLabel("Waiting for the task to complete")
@@ -205,6 +212,7 @@ func runDeleteCmd(opts *DeleteOptions) error {
 					opts.IO.StopProgressIndicator()
 					return fmt.Errorf("can't delete replica %s: %w", replica, err)
 				}
+				tasks = append(tasks, cmdutil.Task{Index: replica, TaskID: res.TaskID})
 				if opts.Wait {
 					_, err := client.WaitForTask(replica, res.TaskID)
 					if err != nil {
@@ -217,6 +225,10 @@ func runDeleteCmd(opts *DeleteOptions) error {
 		opts.IO.StopProgressIndicator()
 	}
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, tasks)
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(
@@ -10,6 +10,7 @@ import (
 	"github.com/algolia/cli/pkg/cmd/indices/delete"
 	"github.com/algolia/cli/pkg/cmd/indices/list"
 	"github.com/algolia/cli/pkg/cmd/indices/move"
+	"github.com/algolia/cli/pkg/cmd/indices/template"
 	"github.com/algolia/cli/pkg/cmdutil"
 )
 
@@ -28,6 +29,7 @@ func NewIndicesCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(move.NewMoveCmd(f, nil))
 	cmd.AddCommand(config.NewConfigCmd(f))
 	cmd.AddCommand(analyze.NewAnalyzeCmd(f))
+	cmd.AddCommand(template.NewTemplateCmd(f))
 
 	return cmd
 }
@@ -0,0 +1,453 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/shared/config"
+	indicesconfig "github.com/algolia/cli/pkg/cmd/shared/handler/indices"
+	"github.com/algolia/cli/pkg/cmdutil"
+	cliconfig "github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/prompt"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+// Status is the status of an index after applying a template
+type Status string
+
+const (
+	// Conforming indices already have the config of the template
+	Conforming Status = "conforming"
+	// Pending indices don't conform to the template, and it wasn't applied (dry run)
+	Pending Status = "pending"
+	// Applied indices didn't conform to the template, and it was applied
+	Applied Status = "applied"
+	// Failed indices couldn't be compared with the template, or it couldn't be applied
+	Failed Status = "failed"
+)
+
+// ApplyOptions represents the options for the apply command
+type ApplyOptions struct {
+	Config cliconfig.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	File        string
+	Template    indicesconfig.ImportConfigJSON
+	Pattern     string
+	DryRun      bool
+	Concurrency int
+	DoConfirm   bool
+	Wait        bool
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// IndexResult is the result of applying a template to an index
+type IndexResult struct {
+	Index   string          `json:"index"`
+	Status  Status          `json:"status"`
+	Changes []config.Change `json:"changes,omitempty"`
+	Error   string          `json:"error,omitempty"`
+
+	// The settings, rules and synonyms to save to conform to the template
+	settings bool
+	rules    []search.Rule
+	synonyms []search.SynonymHit
+}
+
+// NewApplyCmd creates and returns an apply command for index templates
+func NewApplyCmd(f *cmdutil.Factory, runF func(*ApplyOptions) error) *cobra.Command {
+	opts := &ApplyOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var confirm bool
+
+	cmd := &cobra.Command{
+		Use:  "apply <file> --pattern <pattern>",
+		Args: validators.ExactArgs(1),
+		Annotations: map[string]string{
+			"acls": "settings,editSettings",
+		},
+		Short: "Apply a config template (settings, synonyms, rules) to all indices matching a pattern",
+		Long: heredoc.Doc(`
+			Apply a config template (settings, synonyms, rules) to all indices matching a pattern.
+
+			The template uses the same format as the files of the "indices config import" command.
+			The settings, rules and synonyms of the template are added to the config of the indices,
+			other settings, rules and synonyms are left untouched.
+
+			The differences between the template and each index are shown before applying the template.
+			Indices which already conform to the template are skipped.
+		`),
+		Example: heredoc.Doc(`
+			# Apply the template to all indices starting with "tenant_"
+			$ algolia indices template apply template.json --pattern 'tenant_*'
+
+			# Show the differences between the template and the indices, without applying it
+			$ algolia indices template apply template.json --pattern 'tenant_*' --dry-run
+
+			# Apply the template to 10 indices at a time, without confirmation
+			$ algolia indices template apply template.json --pattern 'tenant_*' --concurrency 10 --confirm
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.File = args[0]
+
+			if opts.Concurrency < 1 {
+				return cmdutil.FlagErrorf("--concurrency must be at least 1")
+			}
+			if _, err := path.Match(opts.Pattern, ""); err != nil {
+				return cmdutil.FlagErrorf("invalid pattern %q: %s", opts.Pattern, err)
+			}
+
+			b, err := cmdutil.ReadFile(opts.File, opts.IO.In)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(b, &opts.Template); err != nil {
+				return fmt.Errorf("failed to parse template: %w", err)
+			}
+			if opts.Template.Settings == nil && len(opts.Template.Rules) == 0 &&
+				len(opts.Template.Synonyms) == 0 {
+				return fmt.Errorf("no settings, rules or synonyms found in the template")
+			}
+
+			if !confirm && !opts.DryRun {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf(
+						"--confirm required when non-interactive shell is detected",
+					)
+				}
+				opts.DoConfirm = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runApplyCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		StringVar(&opts.Pattern, "pattern", "", "Apply the template to the indices matching this glob `pattern`")
+	_ = cmd.MarkFlagRequired("pattern")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only show the differences, without applying the template")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 4, "Maximum `number` of indices processed at the same time")
+	cmd.Flags().BoolVarP(&confirm, "confirm", "y", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operations to complete")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runApplyCmd(opts *ApplyOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Listing indices")
+	res, err := client.ListIndices(client.NewApiListIndicesRequest())
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	var indices []string
+	for _, index := range res.Items {
+		if matched, _ := path.Match(opts.Pattern, index.Name); matched {
+			indices = append(indices, index.Name)
+		}
+	}
+	sort.Strings(indices)
+	if len(indices) == 0 {
+		opts.IO.StopProgressIndicator()
+		return fmt.Errorf("no index matches the pattern %q", opts.Pattern)
+	}
+
+	opts.IO.UpdateProgressIndicatorLabel(
+		fmt.Sprintf("Comparing the template with %s", indicesCount(len(indices))),
+	)
+	results := make([]*IndexResult, len(indices))
+	utils.ForEach(len(indices), opts.Concurrency, func(i int) {
+		results[i] = diffIndex(client, indices[i], &opts.Template)
+	})
+	opts.IO.StopProgressIndicator()
+
+	pending := 0
+	for _, r := range results {
+		if r.Status == Pending {
+			pending++
+		}
+	}
+
+	printOutput := opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil
+	if !printOutput {
+		printResults(opts.IO, results)
+	}
+
+	if pending > 0 && !opts.DryRun {
+		if opts.DoConfirm {
+			var confirmed bool
+			err := prompt.Confirm(
+				fmt.Sprintf("Apply the template to %s?", indicesCount(pending)),
+				&confirmed,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to prompt: %w", err)
+			}
+			if !confirmed {
+				return nil
+			}
+		}
+
+		opts.IO.StartProgressIndicatorWithLabel(
+			fmt.Sprintf("Applying the template to %s", indicesCount(pending)),
+		)
+		utils.ForEach(len(results), opts.Concurrency, func(i int) {
+			if results[i].Status == Pending {
+				applyTemplate(client, results[i], &opts.Template, opts.Wait)
+			}
+		})
+		opts.IO.StopProgressIndicator()
+	}
+
+	if printOutput {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, results); err != nil {
+			return err
+		}
+	}
+
+	return summarize(opts, results)
+}
+
+// diffIndex compares the config of an index with the template.
+func diffIndex(
+	client *search.APIClient,
+	index string,
+	template *indicesconfig.ImportConfigJSON,
+) *IndexResult {
+	result := &IndexResult{Index: index, Status: Conforming, Changes: []config.Change{}}
+	fail := func(err error) *IndexResult {
+		result.Status = Failed
+		result.Changes = nil
+		result.Error = err.Error()
+		return result
+	}
+
+	if template.Settings != nil {
+		settings, err := client.GetSettings(client.NewApiGetSettingsRequest(index))
+		if err != nil {
+			return fail(err)
+		}
+		changes, err := config.DiffSettings(settings, template.Settings)
+		if err != nil {
+			return fail(err)
+		}
+		result.settings = len(changes) > 0
+		result.Changes = append(result.Changes, changes...)
+	}
+
+	if len(template.Rules) > 0 {
+		rules, err := config.GetRules(client, index)
+		if err != nil {
+			return fail(err)
+		}
+		changes, err := diffObjects("rules", rules, template.Rules)
+		if err != nil {
+			return fail(err)
+		}
+		for _, rule := range template.Rules {
+			if hasChange(changes, "rules/"+rule.ObjectID) {
+				result.rules = append(result.rules, rule)
+			}
+		}
+		result.Changes = append(result.Changes, changes...)
+	}
+
+	if len(template.Synonyms) > 0 {
+		synonyms, err := config.GetSynonyms(client, index)
+		if err != nil {
+			return fail(err)
+		}
+		changes, err := diffObjects("synonyms", synonyms, template.Synonyms)
+		if err != nil {
+			return fail(err)
+		}
+		for _, synonym := range template.Synonyms {
+			if hasChange(changes, "synonyms/"+synonym.ObjectID) {
+				result.synonyms = append(result.synonyms, synonym)
+			}
+		}
+		result.Changes = append(result.Changes, changes...)
+	}
+
+	if len(result.Changes) > 0 {
+		result.Status = Pending
+	}
+	return result
+}
+
+// diffObjects compares the rules or synonyms of an index with the ones of the template.
+func diffObjects[T any](prefix string, current, expected []T) ([]config.Change, error) {
+	toMaps := func(objects []T) ([]map[string]interface{}, error) {
+		maps := make([]map[string]interface{}, 0, len(objects))
+		for _, o := range objects {
+			m, err := config.ToMap(o)
+			if err != nil {
+				return nil, err
+			}
+			maps = append(maps, m)
+		}
+		return maps, nil
+	}
+
+	currentMaps, err := toMaps(current)
+	if err != nil {
+		return nil, err
+	}
+	expectedMaps, err := toMaps(expected)
+	if err != nil {
+		return nil, err
+	}
+	return config.DiffObjects(prefix, currentMaps, expectedMaps), nil
+}
+
+// hasChange returns true if there's a change for the given key
+func hasChange(changes []config.Change, key string) bool {
+	for _, c := range changes {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTemplate saves the settings, rules and synonyms of the template which differ from the ones of the index.
+func applyTemplate(
+	client *search.APIClient,
+	result *IndexResult,
+	template *indicesconfig.ImportConfigJSON,
+	wait bool,
+) {
+	var taskIDs []int64
+
+	if result.settings {
+		res, err := client.SetSettings(client.NewApiSetSettingsRequest(result.Index, template.Settings))
+		if err != nil {
+			result.Status = Failed
+			result.Error = fmt.Sprintf("failed to save settings: %s", err)
+			return
+		}
+		taskIDs = append(taskIDs, res.TaskID)
+	}
+	if len(result.rules) > 0 {
+		res, err := client.SaveRules(client.NewApiSaveRulesRequest(result.Index, result.rules))
+		if err != nil {
+			result.Status = Failed
+			result.Error = fmt.Sprintf("failed to save rules: %s", err)
+			return
+		}
+		taskIDs = append(taskIDs, res.TaskID)
+	}
+	if len(result.synonyms) > 0 {
+		res, err := client.SaveSynonyms(client.NewApiSaveSynonymsRequest(result.Index, result.synonyms))
+		if err != nil {
+			result.Status = Failed
+			result.Error = fmt.Sprintf("failed to save synonyms: %s", err)
+			return
+		}
+		taskIDs = append(taskIDs, res.TaskID)
+	}
+
+	if wait {
+		for _, taskID := range taskIDs {
+			if _, err := client.WaitForTask(result.Index, taskID); err != nil {
+				result.Status = Failed
+				result.Error = err.Error()
+				return
+			}
+		}
+	}
+
+	result.Status = Applied
+}
+
+// indicesCount returns the number of indices, as a human readable string
+func indicesCount(n int) string {
+	if n == 1 {
+		return "1 index"
+	}
+	return fmt.Sprintf("%d indices", n)
+}
+
+// printResults prints the differences between the template and each index.
+func printResults(io *iostreams.IOStreams, results []*IndexResult) {
+	cs := io.ColorScheme()
+	for _, r := range results {
+		switch r.Status {
+		case Conforming:
+			fmt.Fprintf(io.Out, "%s %s already conforms to the template\n", cs.SuccessIcon(), r.Index)
+		case Pending:
+			fmt.Fprintf(io.Out, "%s %s\n", cs.WarningIcon(), cs.Bold(r.Index))
+			config.PrintChanges(io, "  ", r.Changes)
+		}
+	}
+}
+
+// summarize prints the number of updated indices, and returns an error if any index failed.
+func summarize(opts *ApplyOptions, results []*IndexResult) error {
+	var applied, pending, failed int
+	for _, r := range results {
+		switch r.Status {
+		case Applied:
+			applied++
+		case Pending:
+			pending++
+		case Failed:
+			failed++
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() && !opts.PrintFlags.OutputFlagSpecified() {
+		cs := opts.IO.ColorScheme()
+		switch {
+		case applied > 0:
+			fmt.Fprintf(opts.IO.Out, "%s Applied the template to %s\n", cs.SuccessIcon(), indicesCount(applied))
+		case pending > 0 && opts.DryRun:
+			fmt.Fprintf(opts.IO.Out, "%s %s not conforming to the template\n", cs.WarningIcon(), indicesCount(pending))
+		case failed == 0 && pending == 0:
+			fmt.Fprintf(opts.IO.Out, "%s All indices already conform to the template\n", cs.SuccessIcon())
+		}
+	}
+
+	if failed > 0 {
+		cs := opts.IO.ColorScheme()
+		for _, r := range results {
+			if r.Status == Failed {
+				fmt.Fprintf(opts.IO.ErrOut, "%s %s: %s\n", cs.FailureIcon(), r.Index, r.Error)
+			}
+		}
+		return fmt.Errorf("failed to apply the template to %s", indicesCount(failed))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const template = `{"settings": {"searchableAttributes": ["title", "body"]}}`
+
+func registerIndices(r *httpmock.Registry) {
+	r.Register(
+		httpmock.REST("GET", "1/indexes"),
+		httpmock.JSONResponse(search.ListIndicesResponse{
+			Items: []search.FetchedIndex{{Name: "tenant_a"}, {Name: "tenant_b"}, {Name: "other"}},
+		}),
+	)
+	r.Register(
+		httpmock.REST("GET", "1/indexes/tenant_a/settings"),
+		httpmock.JSONResponse(search.SettingsResponse{SearchableAttributes: []string{"title"}}),
+	)
+	r.Register(
+		httpmock.REST("GET", "1/indexes/tenant_b/settings"),
+		httpmock.JSONResponse(search.SettingsResponse{SearchableAttributes: []string{"title", "body"}}),
+	)
+}
+
+func Test_runApplyCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		apply   bool
+		wantOut string
+	}{
+		{
+			name:  "dry run",
+			cli:   "- --pattern 'tenant_*' --dry-run",
+			isTTY: true,
+			wantOut: `! tenant_a
+  ~ searchableAttributes: ["title"] → ["title","body"]
+✓ tenant_b already conforms to the template
+! 1 index not conforming to the template
+`,
+		},
+		{
+			name:  "apply",
+			cli:   "- --pattern 'tenant_*' --confirm",
+			isTTY: true,
+			apply: true,
+			wantOut: `! tenant_a
+  ~ searchableAttributes: ["title"] → ["title","body"]
+✓ tenant_b already conforms to the template
+✓ Applied the template to 1 index
+`,
+		},
+		{
+			name:    "JSON output",
+			cli:     "- --pattern 'tenant_*' --confirm -o json",
+			apply:   true,
+			wantOut: `[{"index":"tenant_a","status":"applied","changes":[{"kind":"changed","key":"searchableAttributes","before":["title"],"after":["title","body"]}]},{"index":"tenant_b","status":"conforming"}]` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			registerIndices(&r)
+			if tt.apply {
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/tenant_a/settings"),
+					httpmock.JSONResponse(search.UpdatedAtResponse{TaskID: 42}),
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, template)
+			cmd := NewApplyCmd(f, nil)
+			out, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func TestNewApplyCmd_noConfirm(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, template)
+	cmd := NewApplyCmd(f, nil)
+	_, err := test.Execute(cmd, "- --pattern 'tenant_*'", out)
+	assert.EqualError(t, err, "--confirm required when non-interactive shell is detected")
+}
@@ -0,0 +1,20 @@
+package template
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/indices/template/apply"
+	"github.com/algolia/cli/pkg/cmdutil"
+)
+
+// NewTemplateCmd returns a new command for index templates
+func NewTemplateCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage index templates: a config (settings, synonyms, rules) shared by several indices",
+	}
+
+	cmd.AddCommand(apply.NewApplyCmd(f, nil))
+
+	return cmd
+}
@@ -30,6 +30,8 @@ type DeleteOptions struct {
 
 	DoConfirm bool
 	Wait      bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewDeleteCmd creates and returns a delete command for index objects
@@ -40,6 +42,7 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -111,6 +114,8 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	cmd.Flags().
 		BoolVar(&opts.Wait, "wait", false, "Wait for all the operations to complete")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -215,6 +220,10 @@ func runDeleteCmd(opts *DeleteOptions) error {
 		opts.IO.StopProgressIndicator()
 	}
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, cmdutil.IndexTasks(opts.Index, taskIDs))
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(opts.IO.Out, "%s Successfully deleted %s\n", cs.SuccessIcon(), objectNbMessage)
 	}
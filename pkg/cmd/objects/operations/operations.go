@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,6 +33,8 @@ type OperationsOptions struct {
 	Scanner *bufio.Scanner
 
 	ContinueOnError bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewOperationsCmd creates and returns an operations command for object operations
@@ -40,6 +43,7 @@ func NewOperationsCmd(f *cmdutil.Factory, runF func(*OperationsOptions) error) *
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -84,6 +88,8 @@ func NewOperationsCmd(f *cmdutil.Factory, runF func(*OperationsOptions) error) *
 	cmd.Flags().
 		BoolVarP(&opts.ContinueOnError, "continue-on-error", "C", false, "Continue processing operations even if some operations are invalid.")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -191,6 +197,20 @@ func runOperationsCmd(opts *OperationsOptions) error {
 	}
 
 	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		indices := make([]string, 0, len(res.TaskID))
+		for index := range res.TaskID {
+			indices = append(indices, index)
+		}
+		sort.Strings(indices)
+		tasks := make([]cmdutil.Task, 0, len(indices))
+		for _, index := range indices {
+			tasks = append(tasks, cmdutil.Task{Index: index, TaskID: res.TaskID[index]})
+		}
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, tasks)
+	}
+
 	_, err = fmt.Fprintf(
 		opts.IO.Out,
 		"%s Successfully processed %s operations in %v\n",
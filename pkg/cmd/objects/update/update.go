@@ -34,6 +34,8 @@ type UpdateOptions struct {
 	Scanner *bufio.Scanner
 
 	ContinueOnError bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewUpdateCmd creates and returns an update command for index objects
@@ -42,6 +44,7 @@ func NewUpdateCmd(f *cmdutil.Factory, runF func(*UpdateOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -99,6 +102,8 @@ func NewUpdateCmd(f *cmdutil.Factory, runF func(*UpdateOptions) error) *cobra.Co
 	cmd.Flags().
 		BoolVarP(&opts.ContinueOnError, "continue-on-error", "C", false, "Continue updating records even if some are invalid.")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -213,6 +218,15 @@ func runUpdateCmd(opts *UpdateOptions) error {
 	}
 
 	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		tasks := make([]cmdutil.Task, 0, len(responses))
+		for _, res := range responses {
+			tasks = append(tasks, cmdutil.Task{Index: opts.Index, TaskID: res.TaskID})
+		}
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, tasks)
+	}
+
 	_, err = fmt.Fprintf(
 		opts.IO.Out,
 		"%s Successfully updated %s objects on %s in %v\n",
@@ -0,0 +1,27 @@
+package relevance
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	relevancetest "github.com/algolia/cli/pkg/cmd/relevance/test"
+	"github.com/algolia/cli/pkg/cmdutil"
+)
+
+// NewRelevanceCmd returns a new command for relevance testing.
+func NewRelevanceCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relevance",
+		Short: "Test the relevance of your search results",
+		Long: heredoc.Doc(`
+			Test the relevance of your search results.
+
+			Relevance test suites run searches and check their results,
+			so that you can catch relevance regressions in your CI.
+		`),
+	}
+
+	cmd.AddCommand(relevancetest.NewTestCmd(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,292 @@
+package relevancetest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/internal/relevance"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+type TestOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	File          string
+	Suite         *relevance.Suite
+	DefaultParams map[string]any
+	JUnit         string
+	Concurrency   int
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewTestCmd creates and returns a command to run relevance test suites
+func NewTestCmd(f *cmdutil.Factory, runF func(*TestOptions) error) *cobra.Command {
+	opts := &TestOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:  "test -F <file>",
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			"acls": "search",
+		},
+		Short: "Run a relevance test suite.",
+		Long: heredoc.Doc(`
+			This command runs the searches of a test suite and checks their results.
+
+			The suite is a YAML or JSON file with a list of tests.
+			Each test has a query, an index, search parameters and assertions:
+
+			- objectID (and inTop): the record is in the results (in the first inTop hits)
+			- notInResults: the record isn't in the results
+			- nbHits: the number of hits matches a comparison, like "> 10"
+			- firstHit: the first hit has these attribute values, like "brand: Apple"
+
+			The index and the search parameters at the top of the suite are the defaults of the tests.
+			Search parameters given as flags are the defaults of the suite.
+			The results are the hits of the first page: set hitsPerPage to check more hits.
+
+			The searches run concurrently.
+			The command exits with a non-zero status if a test fails.
+		`),
+		Example: heredoc.Doc(`
+			# Run the tests of the "suite.yaml" file
+			$ algolia relevance test -F suite.yaml
+
+			# Run the tests and write a JUnit report for the CI
+			$ algolia relevance test -F suite.yaml --junit report.xml
+
+			# Run the tests with 50 hits per search, unless the suite sets hitsPerPage
+			$ algolia relevance test -F suite.yaml --hitsPerPage 50
+
+			# Example suite:
+			index: PRODUCTS
+			params:
+			  hitsPerPage: 20
+			tests:
+			  - name: iphone
+			    query: iphone
+			    assert:
+			      - objectID: "42"
+			        inTop: 3
+			      - notInResults: "1337"
+			      - nbHits: "> 10"
+			      - firstHit:
+			          brand: Apple
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.File == "" {
+				return cmdutil.FlagErrorf("a test suite file (-F) is required")
+			}
+			if opts.Concurrency < 1 {
+				return cmdutil.FlagErrorf("--concurrency must be at least 1")
+			}
+
+			data, err := cmdutil.ReadFile(opts.File, opts.IO.In)
+			if err != nil {
+				return err
+			}
+			opts.Suite, err = relevance.ParseSuite(data)
+			if err != nil {
+				return err
+			}
+
+			opts.DefaultParams, err = cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
+			if err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runTestCmd(opts)
+		},
+	}
+
+	cmd.SetUsageFunc(
+		cmdutil.UsageFuncWithFilteredAndInheritedFlags(
+			f.IOStreams,
+			cmd,
+			[]string{"file", "junit", "concurrency", "hitsPerPage", "output", "template"},
+		),
+	)
+
+	cmdutil.AddSearchParamsObjectFlags(cmd)
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Run the tests of a suite `file` (use \"-\" to read from standard input)")
+	cmd.Flags().
+		StringVar(&opts.JUnit, "junit", "", "Write a JUnit XML report to a `file`")
+	cmd.Flags().
+		IntVar(&opts.Concurrency, "concurrency", 4, "Maximum `number` of searches at the same time")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runTestCmd(opts *TestOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	tests := opts.Suite.Tests
+	params := make([]search.SearchParamsObject, len(tests))
+	for i, test := range tests {
+		params[i], err = opts.Suite.SearchParams(test, opts.DefaultParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(
+		fmt.Sprintf("Running %s", utils.Pluralize(len(tests), "test")),
+	)
+	start := time.Now()
+	results := make([]relevance.TestResult, len(tests))
+	utils.ForEach(len(tests), opts.Concurrency, func(i int) {
+		test := tests[i]
+		res, err := client.SearchSingleIndex(
+			client.NewApiSearchSingleIndexRequest(test.Index).
+				WithSearchParams(search.SearchParamsObjectAsSearchParams(&params[i])),
+		)
+		if err != nil {
+			results[i] = relevance.TestResult{
+				Name:  test.Name,
+				Index: test.Index,
+				Query: test.Query,
+				Error: err.Error(),
+			}
+			return
+		}
+		results[i] = relevance.Evaluate(test, res)
+	})
+	duration := time.Since(start)
+	opts.IO.StopProgressIndicator()
+
+	if opts.JUnit != "" {
+		if err := writeJUnit(opts, results, duration); err != nil {
+			return err
+		}
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, results); err != nil {
+			return err
+		}
+	} else if err := printResults(opts.IO, results); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if !result.Passed() {
+			return cmdutil.ErrSilent
+		}
+	}
+	return nil
+}
+
+func writeJUnit(opts *TestOptions, results []relevance.TestResult, duration time.Duration) error {
+	name := "relevance"
+	if opts.File != "-" {
+		name = filepath.Base(opts.File)
+	}
+
+	var buf bytes.Buffer
+	if err := relevance.WriteJUnit(&buf, name, results, duration); err != nil {
+		return err
+	}
+	if err := os.WriteFile(opts.JUnit, buf.Bytes(), 0o644); err != nil { // nolint:gosec
+		return fmt.Errorf("failed to write the JUnit report: %w", err)
+	}
+	return nil
+}
+
+func printResults(io *iostreams.IOStreams, results []relevance.TestResult) error {
+	cs := io.ColorScheme()
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("RESULT", nil, nil)
+		table.AddField("TEST", nil, nil)
+		table.AddField("INDEX", nil, nil)
+		table.AddField("ASSERTION", nil, nil)
+		table.AddField("DETAILS", nil, nil)
+		table.EndRow()
+	}
+
+	passed, failed := 0, 0
+	for _, result := range results {
+		if result.Passed() {
+			passed++
+		} else {
+			failed++
+		}
+
+		if result.Error != "" {
+			table.AddField(cs.Red("error"), nil, nil)
+			table.AddField(result.Name, nil, nil)
+			table.AddField(result.Index, nil, nil)
+			table.AddField("search", nil, nil)
+			table.AddField(result.Error, nil, nil)
+			table.EndRow()
+			continue
+		}
+		for _, a := range result.Assertions {
+			if a.Passed {
+				table.AddField(cs.Green("pass"), nil, nil)
+			} else {
+				table.AddField(cs.Red("fail"), nil, nil)
+			}
+			table.AddField(result.Name, nil, nil)
+			table.AddField(result.Index, nil, nil)
+			table.AddField(a.Assertion, nil, nil)
+			table.AddField(a.Message, nil, nil)
+			table.EndRow()
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if io.IsStdoutTTY() {
+		icon := cs.SuccessIcon()
+		if failed > 0 {
+			icon = cs.FailureIcon()
+		}
+		fmt.Fprintf(
+			io.Out,
+			"\n%s %d passed, %d failed, out of %s\n",
+			icon,
+			passed,
+			failed,
+			utils.Pluralize(len(results), "test"),
+		)
+	}
+	return nil
+}
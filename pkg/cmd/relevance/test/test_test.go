@@ -0,0 +1,152 @@
+package relevancetest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const suite = `
+params:
+  hitsPerPage: 3
+tests:
+  - name: iphone
+    index: PRODUCTS
+    query: iphone
+    assert:
+      - objectID: "1"
+        inTop: 1
+      - nbHits: "> 10"
+  - name: samsung
+    index: PRODUCTS_US
+    query: samsung
+    assert:
+      - notInResults: "1"
+`
+
+func Test_runTestCmd(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		usResponse string
+		isTTY      bool
+		wantOut    string
+		wantErr    error
+	}{
+		{
+			name:       "all pass",
+			cli:        "-F -",
+			usResponse: `{"hits":[{"objectID":"2"}],"nbHits":1,"processingTimeMS":1}`,
+			isTTY:      true,
+			wantOut: "RESULT  TEST     INDEX        ASSERTION         DETAILS\n" +
+				"pass    iphone   PRODUCTS     1 in top 1        at position 1\n" +
+				"pass    iphone   PRODUCTS     nbHits > 10       12 hits\n" +
+				"pass    samsung  PRODUCTS_US  1 not in results  not in the 1 hits\n" +
+				"\n✓ 2 passed, 0 failed, out of 2 tests\n",
+		},
+		{
+			name:       "failure",
+			cli:        "-F -",
+			usResponse: `{"hits":[{"objectID":"2"},{"objectID":"1"}],"nbHits":2,"processingTimeMS":1}`,
+			wantOut: "pass\tiphone\tPRODUCTS\t1 in top 1\tat position 1\n" +
+				"pass\tiphone\tPRODUCTS\tnbHits > 10\t12 hits\n" +
+				"fail\tsamsung\tPRODUCTS_US\t1 not in results\tat position 2\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("POST", "1/indexes/PRODUCTS/query"),
+				httpmock.StringResponse(`{"hits":[{"objectID":"1"}],"nbHits":12,"processingTimeMS":2}`),
+			)
+			r.Register(
+				httpmock.REST("POST", "1/indexes/PRODUCTS_US/query"),
+				httpmock.StringResponse(tt.usResponse),
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, suite)
+			cmd := NewTestCmd(f, nil)
+			// Like the root command, don't print the usage on failures
+			cmd.SilenceUsage = true
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_runTestCmd_junit(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("POST", "1/indexes/PRODUCTS/query"),
+		httpmock.StringResponse(`{"hits":[],"nbHits":0,"processingTimeMS":2}`),
+	)
+	r.Register(
+		httpmock.REST("POST", "1/indexes/PRODUCTS_US/query"),
+		httpmock.ErrorResponseWithBody(map[string]any{"message": "Index does not exist", "status": 400}),
+	)
+	defer r.Verify(t)
+
+	dir := t.TempDir()
+	suiteFile := filepath.Join(dir, "suite.yaml")
+	report := filepath.Join(dir, "report.xml")
+	require.NoError(t, os.WriteFile(suiteFile, []byte(suite), 0o600))
+
+	f, out := test.NewFactory(false, &r, nil, "")
+	cmd := NewTestCmd(f, nil)
+	_, err := test.Execute(cmd, "-F "+suiteFile+" --junit "+report+" --concurrency 1", out)
+	assert.Equal(t, cmdutil.ErrSilent, err)
+
+	content, err := os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `<testsuite name="suite.yaml" tests="2" failures="1" errors="1"`)
+	assert.Contains(t, string(content), `<failure message="2 of 2 assertions failed">1 in top 1: not in the 0 hits`)
+	assert.Contains(t, string(content), `<testcase name="samsung" classname="PRODUCTS_US" time="0.000">
+      <error message="API error [400]`)
+}
+
+func Test_NewTestCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		wantErr string
+	}{
+		{
+			name:    "no file",
+			cli:     "",
+			wantErr: "a test suite file (-F) is required",
+		},
+		{
+			name:    "invalid concurrency",
+			cli:     "-F - --concurrency 0",
+			wantErr: "--concurrency must be at least 1",
+		},
+		{
+			name:    "invalid suite",
+			cli:     "-F -",
+			stdin:   "tests: []",
+			wantErr: "invalid suite: no tests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, tt.stdin)
+			cmd := NewTestCmd(f, func(opts *TestOptions) error { return nil })
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
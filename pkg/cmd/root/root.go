@@ -30,10 +30,12 @@ import (
 	"github.com/algolia/cli/pkg/cmd/objects"
 	"github.com/algolia/cli/pkg/cmd/open"
 	"github.com/algolia/cli/pkg/cmd/profile"
+	"github.com/algolia/cli/pkg/cmd/relevance"
 	"github.com/algolia/cli/pkg/cmd/rules"
 	"github.com/algolia/cli/pkg/cmd/search"
 	"github.com/algolia/cli/pkg/cmd/settings"
 	"github.com/algolia/cli/pkg/cmd/synonyms"
+	"github.com/algolia/cli/pkg/cmd/tasks"
 	"github.com/algolia/cli/pkg/cmd/transformations"
 	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
@@ -101,6 +103,7 @@ func NewRootCmd(f *cmdutil.Factory) *cobra.Command {
 
 	// API related commands
 	cmd.AddCommand(search.NewSearchCmd(f))
+	cmd.AddCommand(relevance.NewRelevanceCmd(f))
 	cmd.AddCommand(indices.NewIndicesCmd(f))
 	cmd.AddCommand(objects.NewObjectsCmd(f))
 	cmd.AddCommand(apikeys.NewAPIKeysCmd(f))
@@ -108,6 +111,7 @@ func NewRootCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(rules.NewRulesCmd(f))
 	cmd.AddCommand(synonyms.NewSynonymsCmd(f))
 	cmd.AddCommand(dictionary.NewDictionaryCmd(f))
+	cmd.AddCommand(tasks.NewTasksCmd(f))
 	cmd.AddCommand(events.NewEventsCmd(f))
 	cmd.AddCommand(crawler.NewCrawlersCmd(f))
 	cmd.AddCommand(transformations.NewTransformationsCmd(f))
@@ -8,8 +8,10 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/test"
 )
 
 func TestPrintError(t *testing.T) {
@@ -77,3 +79,41 @@ check your internet connection or https://status.algolia.com
 		})
 	}
 }
+
+// TestNewRootCmd_flags runs subcommands through the root command,
+// so that their flags are merged with the persistent flags of the root, like `--profile` (`-p`).
+func TestNewRootCmd_flags(t *testing.T) {
+	tests := []string{
+		"indices template apply --help",
+		"rules save --help",
+	}
+
+	for _, cli := range tests {
+		t.Run(cli, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, "")
+			cmd := NewRootCmd(f)
+			assert.NotPanics(t, func() {
+				_, err := test.Execute(cmd, cli, out)
+				assert.NoError(t, err)
+			})
+		})
+	}
+}
+
+// TestNewRootCmd_flagShorthands checks that no subcommand redefines a flag of the root command.
+func TestNewRootCmd_flagShorthands(t *testing.T) {
+	f, _ := test.NewFactory(false, nil, nil, "")
+	cmd := NewRootCmd(f)
+
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		assert.NotPanics(t, func() {
+			// Merging the persistent flags of the parents panics if a shorthand is redefined
+			c.InheritedFlags()
+		}, c.CommandPath())
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(cmd)
+}
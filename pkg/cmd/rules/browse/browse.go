@@ -31,7 +31,7 @@ func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
-		PrintFlags:   cmdutil.NewPrintFlags().WithDefaultOutput("json"),
+		PrintFlags:   cmdutil.NewPrintFlags().WithDefaultOutput("json").WithYAMLOutput(),
 	}
 
 	cmd := &cobra.Command{
@@ -50,6 +50,9 @@ func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 
 			# List all the rules of the "MOVIES" index and save them to a 'rules.ndjson' file
 			$ algolia rules browse MOVIES -o json > rules.ndjson
+
+			# List all the rules of the "MOVIES" index and save them to a 'rules.yaml' file
+			$ algolia rules browse MOVIES -o yaml > rules.yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
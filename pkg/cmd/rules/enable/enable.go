@@ -0,0 +1,170 @@
+package enable
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type EnableOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index             string
+	RuleIDs           []string
+	Enabled           bool
+	ForwardToReplicas bool
+	Wait              bool
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewEnableCmd creates and returns an enable command for index rules
+func NewEnableCmd(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Command {
+	return newToggleCmd(f, runF, true, heredoc.Doc(`
+		# Enable the rule with the ID "summer-sale" of the "PRODUCTS" index
+		$ algolia rules enable PRODUCTS summer-sale
+
+		# Enable the rules with the IDs "1" and "2" of the "PRODUCTS" index, but not on its replicas
+		$ algolia rules enable PRODUCTS 1 2 --forward-to-replicas=false
+	`))
+}
+
+// NewDisableCmd creates and returns a disable command for index rules
+func NewDisableCmd(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Command {
+	return newToggleCmd(f, runF, false, heredoc.Doc(`
+		# Disable the rule with the ID "summer-sale" of the "PRODUCTS" index
+		$ algolia rules disable PRODUCTS summer-sale
+
+		# Disable the rules with the IDs "1" and "2" of the "PRODUCTS" index and wait for the operation to complete
+		$ algolia rules disable PRODUCTS 1 2 --wait
+	`))
+}
+
+func newToggleCmd(
+	f *cmdutil.Factory,
+	runF func(*EnableOptions) error,
+	enabled bool,
+	example string,
+) *cobra.Command {
+	opts := &EnableOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		Enabled:      enabled,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	verb, short := "enable", "Enable rules of an index."
+	if !enabled {
+		verb, short = "disable", "Disable rules of an index."
+	}
+
+	cmd := &cobra.Command{
+		Use:               fmt.Sprintf("%s <index> <rule-id>...", verb),
+		Args:              validators.AtLeastNArgs(2),
+		ValidArgsFunction: cmdutil.RuleIDs(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "settings,editSettings",
+		},
+		Short: short,
+		Long: heredoc.Docf(`
+			This command %ss the rules with the given IDs.
+			The other attributes of the rules, like their conditions or their validity, don't change.
+		`, verb),
+		Example: example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			opts.RuleIDs = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runEnableCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", true, "Whether to update the rules on replica indices")
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runEnableCmd(opts *EnableOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	// Get all the rules first, so that no rule is updated if one of them doesn't exist
+	rules := make([]map[string]interface{}, 0, len(opts.RuleIDs))
+	opts.IO.StartProgressIndicatorWithLabel("Fetching rules")
+	for _, id := range opts.RuleIDs {
+		rule, err := shared.GetRawRule(client, opts.Index, id)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		rules = append(rules, rule)
+	}
+
+	var taskIDs []int64
+	opts.IO.UpdateProgressIndicatorLabel("Updating rules")
+	for _, rule := range rules {
+		rule["enabled"] = opts.Enabled
+		taskID, err := shared.SaveRawRule(client, opts.Index, rule, opts.ForwardToReplicas)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	if opts.Wait {
+		opts.IO.UpdateProgressIndicatorLabel("Waiting for the tasks to complete")
+		for _, taskID := range taskIDs {
+			if _, err := client.WaitForTask(opts.Index, taskID); err != nil {
+				opts.IO.StopProgressIndicator()
+				return err
+			}
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, cmdutil.IndexTasks(opts.Index, taskIDs))
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		verb := "Enabled"
+		if !opts.Enabled {
+			verb = "Disabled"
+		}
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(
+			opts.IO.Out,
+			"%s %s %s on %s\n",
+			cs.SuccessIcon(),
+			verb,
+			utils.Pluralize(len(opts.RuleIDs), "rule"),
+			opts.Index,
+		)
+	}
+
+	return nil
+}
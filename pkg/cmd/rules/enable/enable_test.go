@@ -0,0 +1,99 @@
+package enable
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runEnableCmd(t *testing.T) {
+	tests := []struct {
+		name              string
+		disable           bool
+		cli               string
+		isTTY             bool
+		wantBody          string
+		forwardToReplicas string
+		wantOut           string
+	}{
+		{
+			name:              "enable",
+			cli:               "PRODUCTS 1",
+			isTTY:             true,
+			wantBody:          `{"objectID":"1","enabled":true,"condition":{"pattern":"sale","anchoring":"contains"},"consequence":{}}`,
+			forwardToReplicas: "true",
+			wantOut:           "✓ Enabled 1 rule on PRODUCTS\n",
+		},
+		{
+			name:              "disable without replicas",
+			disable:           true,
+			cli:               "PRODUCTS 1 -f=false",
+			isTTY:             true,
+			wantBody:          `{"objectID":"1","enabled":false,"condition":{"pattern":"sale","anchoring":"contains"},"consequence":{}}`,
+			forwardToReplicas: "false",
+			wantOut:           "✓ Disabled 1 rule on PRODUCTS\n",
+		},
+		{
+			name:              "JSON output",
+			disable:           true,
+			cli:               "PRODUCTS 1 -o json",
+			wantBody:          `{"objectID":"1","enabled":false,"condition":{"pattern":"sale","anchoring":"contains"},"consequence":{}}`,
+			forwardToReplicas: "true",
+			wantOut:           "[{\"index\":\"PRODUCTS\",\"taskID\":42}]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			// The rule uses the legacy `condition` attribute, which must be kept as is
+			r.Register(
+				httpmock.REST("GET", "1/indexes/PRODUCTS/rules/1"),
+				httpmock.StringResponse(
+					`{"objectID":"1","enabled":true,"condition":{"pattern":"sale","anchoring":"contains"},"consequence":{},"_metadata":{"lastUpdate":1}}`,
+				),
+			)
+			r.Register(
+				httpmock.REST("PUT", "1/indexes/PRODUCTS/rules/1"),
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, tt.wantBody, string(body))
+					assert.Equal(t, tt.forwardToReplicas, req.URL.Query().Get("forwardToReplicas"))
+					return httpmock.StringResponse(`{"objectID":"1","taskID":42,"updatedAt":"2025-01-01T00:00:00Z"}`)(req)
+				},
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewEnableCmd(f, nil)
+			if tt.disable {
+				cmd = NewDisableCmd(f, nil)
+			}
+			_, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_runEnableCmd_missingRule(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("GET", "1/indexes/PRODUCTS/rules/1"),
+		httpmock.StringResponse(`{"objectID":"1","consequence":{}}`),
+	)
+	r.Register(httpmock.REST("GET", "1/indexes/PRODUCTS/rules/2"), httpmock.ErrorResponse())
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(false, &r, nil, "")
+	cmd := NewEnableCmd(f, nil)
+	_, err := test.Execute(cmd, "PRODUCTS 1 2", out)
+	assert.EqualError(t, err, "rule 2 doesn't exist in PRODUCTS")
+}
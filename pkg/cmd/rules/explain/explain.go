@@ -0,0 +1,356 @@
+package explain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type ExplainOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index        string
+	SearchParams *search.SearchParamsObject
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// Explanation describes how the rules of an index changed the results of a search.
+type Explanation struct {
+	Query            string                   `json:"query"`
+	Params           string                   `json:"params"`
+	NbHits           int32                    `json:"nbHits"`
+	AppliedRules     []AppliedRule            `json:"appliedRules"`
+	UserData         interface{}              `json:"userData,omitempty"`
+	RenderingContent *search.RenderingContent `json:"renderingContent,omitempty"`
+	Hits             []ExplainedHit           `json:"hits"`
+}
+
+// AppliedRule is a rule that matched the search, with its consequences.
+type AppliedRule struct {
+	ObjectID    string                 `json:"objectID"`
+	Description string                 `json:"description,omitempty"`
+	Conditions  []search.Condition     `json:"conditions,omitempty"`
+	Params      map[string]interface{} `json:"params,omitempty"`
+	Promoted    []Promotion            `json:"promoted,omitempty"`
+	Hidden      []string               `json:"hidden,omitempty"`
+}
+
+// Promotion is a record promoted by a rule, at a position of the results.
+type Promotion struct {
+	ObjectID string `json:"objectID"`
+	Position int32  `json:"position"`
+}
+
+// ExplainedHit is a hit of the search, with the rule that promoted it.
+type ExplainedHit struct {
+	Position   int    `json:"position"`
+	ObjectID   string `json:"objectID"`
+	Promoted   bool   `json:"promoted"`
+	PromotedBy string `json:"promotedBy,omitempty"`
+}
+
+// NewExplainCmd creates and returns an explain command for index rules
+func NewExplainCmd(f *cmdutil.Factory, runF func(*ExplainOptions) error) *cobra.Command {
+	opts := &ExplainOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "explain <index> --query <query> [--ruleContexts <contexts>]",
+		Args:              validators.ExactArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "search,settings",
+		},
+		Short: "Explain which rules applied to a search.",
+		Long: heredoc.Doc(`
+			This command runs a search and explains how the rules of the index changed its results:
+			which rules matched, the search parameters they set, the records they promoted or hid,
+			the user data and rendering content they returned.
+
+			All the search parameters of "algolia search" are accepted, like --ruleContexts or --filters.
+		`),
+		Example: heredoc.Doc(`
+			# Explain which rules applied to the "star wars" query on the "MOVIES" index
+			$ algolia rules explain MOVIES --query "star wars"
+
+			# Explain which rules applied to the "star wars" query with the "mobile" rule context
+			$ algolia rules explain MOVIES --query "star wars" --ruleContexts mobile
+
+			# Get the explanation as JSON
+			$ algolia rules explain MOVIES --query "star wars" -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+
+			searchParams, err := cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
+			if err != nil {
+				return err
+			}
+			// Required to know which hits are promoted
+			searchParams["getRankingInfo"] = true
+
+			tmp, err := json.Marshal(searchParams)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(tmp, &opts.SearchParams); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runExplainCmd(opts)
+		},
+	}
+
+	cmd.SetUsageFunc(
+		cmdutil.UsageFuncWithFilteredAndInheritedFlags(
+			f.IOStreams,
+			cmd,
+			[]string{"query", "ruleContexts", "filters", "hitsPerPage", "output"},
+		),
+	)
+
+	cmdutil.AddSearchParamsObjectFlags(cmd)
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runExplainCmd(opts *ExplainOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Searching")
+	res, err := client.SearchSingleIndex(
+		client.NewApiSearchSingleIndexRequest(opts.Index).
+			WithSearchParams(search.SearchParamsObjectAsSearchParams(opts.SearchParams)),
+	)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	opts.IO.UpdateProgressIndicatorLabel("Fetching the applied rules")
+	explanation, err := explain(client, opts.Index, res)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, explanation)
+	}
+
+	return printExplanation(opts, explanation)
+}
+
+// explain fetches the rules applied to the search, and matches their promotions with the hits.
+func explain(client *search.APIClient, index string, res *search.SearchResponse) (*Explanation, error) {
+	explanation := &Explanation{
+		Query:            res.Query,
+		Params:           res.Params,
+		NbHits:           res.GetNbHits(),
+		AppliedRules:     []AppliedRule{},
+		RenderingContent: res.RenderingContent,
+		Hits:             []ExplainedHit{},
+	}
+	if res.UserData != nil {
+		explanation.UserData = res.UserData
+	}
+
+	promotedBy := map[string]string{}
+	for _, applied := range res.AppliedRules {
+		id, _ := applied["objectID"].(string)
+		if id == "" {
+			continue
+		}
+		rule := AppliedRule{ObjectID: id}
+
+		r, err := client.GetRule(client.NewApiGetRuleRequest(index, id))
+		var apiErr *search.APIError
+		switch {
+		case err == nil:
+			rule = newAppliedRule(r)
+		case errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound:
+			// The rule was deleted since the search
+		default:
+			return nil, fmt.Errorf("failed to get rule %s: %w", id, err)
+		}
+
+		for _, p := range rule.Promoted {
+			if _, ok := promotedBy[p.ObjectID]; !ok {
+				promotedBy[p.ObjectID] = rule.ObjectID
+			}
+		}
+		explanation.AppliedRules = append(explanation.AppliedRules, rule)
+	}
+
+	offset := int(res.GetPage() * res.GetHitsPerPage())
+	for i, hit := range res.Hits {
+		h := ExplainedHit{Position: offset + i, ObjectID: hit.ObjectID}
+		if hit.RankingInfo != nil && hit.RankingInfo.Promoted != nil {
+			h.Promoted = *hit.RankingInfo.Promoted
+		}
+		if h.Promoted {
+			h.PromotedBy = promotedBy[hit.ObjectID]
+		}
+		explanation.Hits = append(explanation.Hits, h)
+	}
+
+	return explanation, nil
+}
+
+func newAppliedRule(r *search.Rule) AppliedRule {
+	rule := AppliedRule{
+		ObjectID:    r.ObjectID,
+		Description: r.GetDescription(),
+		Conditions:  r.Conditions,
+	}
+	if r.Consequence.Params != nil {
+		b, err := json.Marshal(r.Consequence.Params)
+		if err == nil {
+			_ = json.Unmarshal(b, &rule.Params)
+		}
+	}
+	for _, p := range r.Consequence.Promote {
+		if p.PromoteObjectID != nil {
+			rule.Promoted = append(rule.Promoted, Promotion{
+				ObjectID: p.PromoteObjectID.ObjectID,
+				Position: p.PromoteObjectID.Position,
+			})
+		}
+		if p.PromoteObjectIDs != nil {
+			// The records are promoted as a group, starting at the position
+			for i, id := range p.PromoteObjectIDs.ObjectIDs {
+				rule.Promoted = append(rule.Promoted, Promotion{
+					ObjectID: id,
+					Position: p.PromoteObjectIDs.Position + int32(i),
+				})
+			}
+		}
+	}
+	for _, h := range r.Consequence.Hide {
+		rule.Hidden = append(rule.Hidden, h.ObjectID)
+	}
+	return rule
+}
+
+func printExplanation(opts *ExplainOptions, explanation *Explanation) error {
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(
+		out,
+		"Query %q on %s: %s, %s\n",
+		explanation.Query,
+		opts.Index,
+		utils.Pluralize(int(explanation.NbHits), "hit"),
+		utils.Pluralize(len(explanation.AppliedRules), "applied rule"),
+	)
+
+	if len(explanation.AppliedRules) == 0 {
+		fmt.Fprintln(out, "\nNo rules applied to the query")
+	} else {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Applied rules"))
+	}
+	for _, rule := range explanation.AppliedRules {
+		title := cs.Bold(rule.ObjectID)
+		if rule.Description != "" {
+			title += " " + cs.Gray(rule.Description)
+		}
+		fmt.Fprintf(out, "  %s %s\n", cs.SuccessIcon(), title)
+		if conditions := shared.FormatConditions(rule.Conditions); conditions != "" {
+			fmt.Fprintf(out, "      conditions: %s\n", conditions)
+		}
+		if len(rule.Params) > 0 {
+			b, err := json.Marshal(rule.Params)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "      params: %s\n", b)
+		}
+		if len(rule.Promoted) > 0 {
+			promoted := make([]string, 0, len(rule.Promoted))
+			for _, p := range rule.Promoted {
+				promoted = append(promoted, fmt.Sprintf("%s at position %d", p.ObjectID, p.Position))
+			}
+			fmt.Fprintf(out, "      promoted: %s\n", strings.Join(promoted, ", "))
+		}
+		if len(rule.Hidden) > 0 {
+			fmt.Fprintf(out, "      hidden: %s\n", strings.Join(rule.Hidden, ", "))
+		}
+	}
+
+	if explanation.UserData != nil {
+		b, err := json.Marshal(explanation.UserData)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n%s\n  %s\n", cs.Bold("User data"), b)
+	}
+	if explanation.RenderingContent != nil {
+		b, err := json.Marshal(explanation.RenderingContent)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n%s\n  %s\n", cs.Bold("Rendering content"), b)
+	}
+
+	if len(explanation.Hits) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "\n%s\n", cs.Bold("Hits"))
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("POSITION", nil, nil)
+		table.AddField("OBJECT ID", nil, nil)
+		table.AddField("PROMOTED", nil, nil)
+		table.EndRow()
+	}
+	for _, hit := range explanation.Hits {
+		promoted := "no"
+		if hit.Promoted {
+			promoted = "yes"
+			if hit.PromotedBy != "" {
+				promoted = "by " + hit.PromotedBy
+			}
+		}
+		table.AddField(fmt.Sprint(hit.Position), nil, nil)
+		table.AddField(hit.ObjectID, nil, nil)
+		table.AddField(promoted, nil, nil)
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -0,0 +1,167 @@
+package explain
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func searchResponse(appliedRules ...string) map[string]interface{} {
+	applied := []map[string]interface{}{}
+	for _, id := range appliedRules {
+		applied = append(applied, map[string]interface{}{"objectID": id})
+	}
+	return map[string]interface{}{
+		"query":        "star wars",
+		"params":       "query=star+wars&getRankingInfo=true",
+		"nbHits":       2,
+		"page":         0,
+		"hitsPerPage":  20,
+		"appliedRules": applied,
+		"hits": []map[string]interface{}{
+			{"objectID": "sw4", "_rankingInfo": map[string]interface{}{"promoted": true}},
+			{"objectID": "sw5"},
+		},
+	}
+}
+
+func Test_runExplainCmd(t *testing.T) {
+	promoteRule := search.Rule{
+		ObjectID:    "promote-sw4",
+		Description: utils.ToPtr("Promote the first movie"),
+		Consequence: search.Consequence{
+			Promote: []search.Promote{
+				*search.PromoteObjectIDAsPromote(
+					search.NewEmptyPromoteObjectID().SetObjectID("sw4").SetPosition(0),
+				),
+			},
+			Hide:   []search.ConsequenceHide{{ObjectID: "sw1"}},
+			Params: search.NewEmptyConsequenceParams().SetFilters("genre:scifi"),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		cli          string
+		isTTY        bool
+		appliedRules []string
+		rules        []search.Rule
+		wantOut      string
+	}{
+		{
+			name:         "no applied rules",
+			cli:          "MOVIES --query \"star wars\"",
+			isTTY:        true,
+			appliedRules: []string{},
+			wantOut: `Query "star wars" on MOVIES: 2 hits, 0 applied rule
+
+No rules applied to the query
+
+Hits
+POSITION  OBJECT ID  PROMOTED
+0         sw4        yes
+1         sw5        no
+`,
+		},
+		{
+			name:         "applied rule, TTY",
+			cli:          "MOVIES --query \"star wars\"",
+			isTTY:        true,
+			appliedRules: []string{"promote-sw4"},
+			rules:        []search.Rule{promoteRule},
+			wantOut: `Query "star wars" on MOVIES: 2 hits, 1 applied rule
+
+Applied rules
+  ✓ promote-sw4 Promote the first movie
+      params: {"filters":"genre:scifi"}
+      promoted: sw4 at position 0
+      hidden: sw1
+
+Hits
+POSITION  OBJECT ID  PROMOTED
+0         sw4        by promote-sw4
+1         sw5        no
+`,
+		},
+		{
+			name:         "deleted rule",
+			cli:          "MOVIES --query \"star wars\"",
+			appliedRules: []string{"deleted"},
+			wantOut: `Query "star wars" on MOVIES: 2 hits, 1 applied rule
+
+Applied rules
+  ✓ deleted
+
+Hits
+0	sw4	yes
+1	sw5	no
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("POST", "1/indexes/MOVIES/query"),
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					var params map[string]interface{}
+					require.NoError(t, json.Unmarshal(body, &params))
+					assert.Equal(t, true, params["getRankingInfo"])
+					return httpmock.JSONResponse(searchResponse(tt.appliedRules...))(req)
+				},
+			)
+			for _, id := range tt.appliedRules {
+				found := false
+				for _, rule := range tt.rules {
+					if rule.ObjectID == id {
+						r.Register(httpmock.REST("GET", "1/indexes/MOVIES/rules/"+id), httpmock.JSONResponse(rule))
+						found = true
+					}
+				}
+				if !found {
+					r.Register(httpmock.REST("GET", "1/indexes/MOVIES/rules/"+id), httpmock.ErrorResponse())
+				}
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewExplainCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_runExplainCmd_json(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(httpmock.REST("POST", "1/indexes/MOVIES/query"), httpmock.JSONResponse(searchResponse()))
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(false, &r, nil, "")
+	cmd := NewExplainCmd(f, nil)
+	_, err := test.Execute(cmd, "MOVIES --query \"star wars\" -o json", out)
+	require.NoError(t, err)
+
+	var explanation Explanation
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &explanation))
+	assert.Equal(t, "star wars", explanation.Query)
+	assert.Empty(t, explanation.AppliedRules)
+	assert.Equal(t, []ExplainedHit{
+		{Position: 0, ObjectID: "sw4", Promoted: true},
+		{Position: 1, ObjectID: "sw5"},
+	}, explanation.Hits)
+}
@@ -0,0 +1,101 @@
+package get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type GetOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index   string
+	RuleIDs []string
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewGetCmd creates and returns a get command for index rules
+func NewGetCmd(f *cmdutil.Factory, runF func(*GetOptions) error) *cobra.Command {
+	opts := &GetOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags().WithDefaultOutput("json"),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "get <index> <rule-id>...",
+		Args:              validators.AtLeastNArgs(2),
+		ValidArgsFunction: cmdutil.RuleIDs(opts.SearchClient),
+		Annotations: map[string]string{
+			"runInWebCLI": "true",
+			"acls":        "settings",
+		},
+		Short: "Get rules of an index by ID.",
+		Long: heredoc.Doc(`
+			This command prints the rules with the given IDs, one JSON object per line.
+		`),
+		Example: heredoc.Doc(`
+			# Get the rule with the ID "1" of the "MOVIES" index
+			$ algolia rules get MOVIES 1
+
+			# Get the rules with the IDs "1" and "2" of the "MOVIES" index
+			$ algolia rules get MOVIES 1 2
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			opts.RuleIDs = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runGetCmd(opts)
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runGetCmd(opts *GetOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	p, err := opts.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range opts.RuleIDs {
+		rule, err := client.GetRule(client.NewApiGetRuleRequest(opts.Index, id))
+		if err != nil {
+			var apiErr *search.APIError
+			if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+				return fmt.Errorf("rule %s doesn't exist in %s", id, opts.Index)
+			}
+			return fmt.Errorf("failed to get rule %s: %w", id, err)
+		}
+		if err := p.Print(opts.IO, rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
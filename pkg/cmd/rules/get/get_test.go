@@ -0,0 +1,72 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runGetCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		rules   []string
+		missing bool
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "single rule",
+			cli:     "MOVIES 1",
+			rules:   []string{"1"},
+			wantOut: "{\"consequence\":{},\"objectID\":\"1\"}\n",
+		},
+		{
+			name:    "multiple rules",
+			cli:     "MOVIES 1 2",
+			rules:   []string{"1", "2"},
+			wantOut: "{\"consequence\":{},\"objectID\":\"1\"}\n{\"consequence\":{},\"objectID\":\"2\"}\n",
+		},
+		{
+			name:    "missing rule",
+			cli:     "MOVIES 3",
+			missing: true,
+			wantErr: "rule 3 doesn't exist in MOVIES",
+		},
+		{
+			name:    "missing rule ID",
+			cli:     "MOVIES",
+			wantErr: "`get` requires at least 2 arguments.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			for _, id := range tt.rules {
+				r.Register(
+					httpmock.REST("GET", "1/indexes/MOVIES/rules/"+id),
+					httpmock.JSONResponse(search.Rule{ObjectID: id}),
+				)
+			}
+			if tt.missing {
+				r.Register(httpmock.REST("GET", "1/indexes/MOVIES/rules/3"), httpmock.ErrorResponse())
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(false, &r, nil, "")
+			cmd := NewGetCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
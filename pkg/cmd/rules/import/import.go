@@ -29,6 +29,8 @@ type ImportOptions struct {
 	Scanner            *bufio.Scanner
 
 	DoConfirm bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewImportCmd creates and returns an import command for index rules
@@ -37,10 +39,11 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	var confirm bool
-	var file string
+	var file, format string
 
 	cmd := &cobra.Command{
 		Use:               "import <index> -F <file>",
@@ -52,12 +55,16 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		Short: "Import Rules into an index.",
 		Long: heredoc.Doc(`
 			Import Rules into an index.
-			File imports must contain one JSON rule per line (newline delimited JSON objects - ndjson format: https://ndjson.org/).
+			File imports must contain one JSON rule per line (newline delimited JSON objects - ndjson format: https://ndjson.org/),
+			or YAML documents with one rule or a list of rules each (for files with a .yaml or .yml extension, or with --format yaml).
 		`),
 		Example: heredoc.Doc(`
 			# Import rules from the "rules.ndjson" file to the "MOVIES" index
 			$ algolia rules import MOVIES -F rules.ndjson
 
+			# Import rules from the "rules.yaml" file to the "MOVIES" index
+			$ algolia rules import MOVIES -F rules.yaml
+
 			# Import rules from the standard input to the "MOVIES" index
 			$ cat rules.ndjson | algolia rules import MOVIES -F -
 
@@ -79,7 +86,15 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 				opts.DoConfirm = true
 			}
 
-			scanner, err := cmdutil.ScanFile(file, opts.IO.In)
+			fileFormat, err := cmdutil.FileFormat(format, file, "ndjson", "yaml")
+			if err != nil {
+				return err
+			}
+			scan := cmdutil.ScanFile
+			if fileFormat == "yaml" {
+				scan = cmdutil.ScanYAMLFile
+			}
+			scanner, err := scan(file, opts.IO.In)
 			if err != nil {
 				return err
 			}
@@ -98,6 +113,12 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 	cmd.Flags().
 		StringVarP(&file, "file", "F", "", "Import rules from a `file` (use \"-\" to read from standard input)")
 	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().
+		StringVar(&format, "format", "", "Format of the file: ndjson or yaml (default: detected from the file extension, else ndjson)")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"ndjson": "one JSON rule per line",
+		"yaml":   "YAML documents or lists of rules",
+	}))
 
 	cmd.Flags().
 		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", true, "Whether to add the rules to replica indices")
@@ -105,6 +126,8 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		BoolVarP(&opts.ClearExistingRules, "clear-existing-rules", "c", false, "Delete existing rules before importing new ones")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "wait for the operation to complete")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -137,6 +160,7 @@ func runImportCmd(opts *ImportOptions) error {
 		rules      = make([]search.Rule, 0, batchSize)
 		count      = 0
 		totalCount = 0
+		taskIDs    []int64
 	)
 
 	clearExistingRules := opts.ClearExistingRules
@@ -167,6 +191,7 @@ func runImportCmd(opts *ImportOptions) error {
 				opts.IO.StopProgressIndicator()
 				return err
 			}
+			taskIDs = append(taskIDs, res.TaskID)
 			if opts.Wait {
 				_, err := client.WaitForTask(opts.Index, res.TaskID)
 				if err != nil {
@@ -193,6 +218,7 @@ func runImportCmd(opts *ImportOptions) error {
 			opts.IO.StopProgressIndicator()
 			return err
 		}
+		taskIDs = append(taskIDs, res.TaskID)
 		if opts.Wait {
 			_, err := client.WaitForTask(opts.Index, res.TaskID)
 			if err != nil {
@@ -211,6 +237,7 @@ func runImportCmd(opts *ImportOptions) error {
 			opts.IO.StopProgressIndicator()
 			return err
 		}
+		taskIDs = append(taskIDs, res.TaskID)
 		if opts.Wait {
 			_, err := client.WaitForTask(opts.Index, res.TaskID)
 			if err != nil {
@@ -226,6 +253,10 @@ func runImportCmd(opts *ImportOptions) error {
 		return err
 	}
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, cmdutil.IndexTasks(opts.Index, taskIDs))
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(
@@ -2,6 +2,7 @@ package importrules
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -116,6 +117,10 @@ func Test_runExportCmd(t *testing.T) {
 	err := os.WriteFile(tmpFile, []byte("{\"objectID\":\"test\"}"), 0o600)
 	require.NoError(t, err)
 
+	yamlFile := filepath.Join(t.TempDir(), "rules.yaml")
+	err = os.WriteFile(yamlFile, []byte("- objectID: test\n- objectID: test2\n"), 0o600)
+	require.NoError(t, err)
+
 	var largeBatchBuilder strings.Builder
 	for i := 0; i < 1001; i += 1 {
 		largeBatchBuilder.Write([]byte("{\"objectID\":\"test\"}\n"))
@@ -152,6 +157,43 @@ func Test_runExportCmd(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:    "from YAML file",
+			cli:     fmt.Sprintf("foo -F '%s'", yamlFile),
+			wantOut: "✓ Successfully imported 2 rules to foo\n",
+			setup: func(r *httpmock.Registry) {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/foo/rules/batch"),
+					func(req *http.Request) (*http.Response, error) {
+						body, err := io.ReadAll(req.Body)
+						require.NoError(t, err)
+						assert.JSONEq(
+							t,
+							`[{"objectID":"test","consequence":{}},{"objectID":"test2","consequence":{}}]`,
+							string(body),
+						)
+						return httpmock.JSONResponse(search.UpdatedAtResponse{})(req)
+					},
+				)
+			},
+		},
+		{
+			name:    "from stdin with YAML documents",
+			cli:     "foo -F - --format yaml",
+			stdin:   "objectID: test\n---\nobjectID: test2\n",
+			wantOut: "✓ Successfully imported 2 rules to foo\n",
+			setup: func(r *httpmock.Registry) {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/foo/rules/batch"),
+					httpmock.JSONResponse(search.UpdatedAtResponse{}),
+				)
+			},
+		},
+		{
+			name:    "with an invalid format",
+			cli:     "foo -F - --format xml",
+			wantErr: "invalid format \"xml\", expected one of: ndjson, yaml",
+		},
 		{
 			name:    "from stdin with invalid JSON",
 			cli:     "foo -F -",
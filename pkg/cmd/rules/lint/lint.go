@@ -0,0 +1,253 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/internal/lint"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+type LintOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index    string
+	File     string
+	Contexts []string
+	FailOn   lint.Severity
+	Now      func() time.Time
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewLintCmd creates and returns a lint command for index rules
+func NewLintCmd(f *cmdutil.Factory, runF func(*LintOptions) error) *cobra.Command {
+	opts := &LintOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		Now:          time.Now,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var failOn string
+
+	cmd := &cobra.Command{
+		Use:               "lint [<index>] [-F <file>]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "browse,settings",
+		},
+		Short: "Find problems in the rules of an index.",
+		Long: heredoc.Doc(`
+			This command checks the rules of an index, or of a file with one JSON rule per line, and reports:
+
+			- error: rules with identical conditions that can be active at the same time
+			- error: promotions of records that don't exist in the index
+			- warning: rules whose validity windows have all passed
+			- warning: filters on attributes that aren't in attributesForFaceting (or numericAttributesForFiltering)
+			- warning: rules with a context that isn't in --contexts
+			- info: contexts of --contexts that no rule uses
+
+			Promotions and filters are only checked against an index: with a file, give the index to check against as an argument.
+			The command exits with a non-zero status if it finds issues at least as severe as --fail-on.
+		`),
+		Example: heredoc.Doc(`
+			# Lint the rules of the "MOVIES" index
+			$ algolia rules lint MOVIES
+
+			# Lint the rules of the "rules.ndjson" file, without checking them against an index
+			$ algolia rules lint -F rules.ndjson
+
+			# Lint the rules of the "rules.ndjson" file before importing them to the "MOVIES" index, and fail on warnings
+			$ algolia rules lint MOVIES -F rules.ndjson --fail-on warning
+
+			# Lint the rules of the "MOVIES" index, for an application that sends the "mobile" and "desktop" rule contexts
+			$ algolia rules lint MOVIES --contexts mobile,desktop
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && opts.File == "" {
+				return cmdutil.FlagErrorf("an index or a file (-F) is required")
+			}
+			if len(args) > 0 {
+				opts.Index = args[0]
+			}
+
+			severity, ok := lint.ParseSeverity(failOn)
+			if !ok {
+				return cmdutil.FlagErrorf("invalid --fail-on %q, expected one of: error, warning, info", failOn)
+			}
+			opts.FailOn = severity
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runLintCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Lint the rules of a `file` with one JSON rule per line (use \"-\" to read from standard input)")
+	cmd.Flags().
+		StringSliceVar(&opts.Contexts, "contexts", nil, "Rule contexts sent by the search requests of your application")
+	cmd.Flags().
+		StringVar(&failOn, "fail-on", string(lint.Error), "Exit with a non-zero status on issues of this severity or above: error, warning or info")
+	_ = cmd.RegisterFlagCompletionFunc("fail-on", cmdutil.StringCompletionFunc(map[string]string{
+		string(lint.Error):   "Fail on errors only",
+		string(lint.Warning): "Fail on warnings and errors",
+		string(lint.Info):    "Fail on any issue",
+	}))
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runLintCmd(opts *LintOptions) error {
+	var client *search.APIClient
+	if opts.Index != "" {
+		var err error
+		client, err = opts.SearchClient()
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Fetching rules")
+	rules, err := readRules(client, opts)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	lintOpts := lint.RulesOptions{
+		Now:      opts.Now(),
+		Contexts: opts.Contexts,
+	}
+	if client != nil {
+		opts.IO.UpdateProgressIndicatorLabel("Fetching settings")
+		settings, err := client.GetSettings(client.NewApiGetSettingsRequest(opts.Index))
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		lintOpts.FacetAttributes = attributeNames(settings.AttributesForFaceting)
+		lintOpts.NumericAttributes = attributeNames(settings.NumericAttributesForFiltering)
+
+		if hasPromotions(rules) {
+			opts.IO.UpdateProgressIndicatorLabel("Browsing records")
+			lintOpts.ObjectIDs, err = browseObjectIDs(client, opts.Index)
+			if err != nil {
+				opts.IO.StopProgressIndicator()
+				return err
+			}
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	issues := lint.Rules(rules, lintOpts)
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, issues); err != nil {
+			return err
+		}
+	} else if err := lint.PrintIssues(opts.IO, issues, len(rules), "rule"); err != nil {
+		return err
+	}
+
+	if lint.HasIssues(issues, opts.FailOn) {
+		return cmdutil.ErrSilent
+	}
+	return nil
+}
+
+// readRules reads the rules from the file, or browses the rules of the index.
+func readRules(client *search.APIClient, opts *LintOptions) ([]search.Rule, error) {
+	var rules []search.Rule
+
+	if opts.File != "" {
+		scanner, err := cmdutil.ScanFile(opts.File, opts.IO.In)
+		if err != nil {
+			return nil, err
+		}
+		line := 0
+		for scanner.Scan() {
+			line++
+			text := scanner.Text()
+			if text == "" {
+				continue
+			}
+			var rule search.Rule
+			if err := json.Unmarshal([]byte(text), &rule); err != nil {
+				return nil, fmt.Errorf("failed to parse JSON rule on line %d: %s", line, err)
+			}
+			rules = append(rules, rule)
+		}
+		return rules, scanner.Err()
+	}
+
+	err := client.BrowseRules(
+		opts.Index,
+		*search.NewEmptySearchRulesParams(),
+		search.WithAggregator(func(res any, _ error) {
+			if res == nil {
+				return
+			}
+			rules = append(rules, res.(*search.SearchRulesResponse).Hits...)
+		}),
+	)
+	return rules, err
+}
+
+// browseObjectIDs returns the objectIDs of all the records of the index.
+func browseObjectIDs(client *search.APIClient, index string) (map[string]bool, error) {
+	objectIDs := map[string]bool{}
+	err := client.BrowseObjects(
+		index,
+		*search.NewEmptyBrowseParamsObject().SetAttributesToRetrieve([]string{"objectID"}),
+		search.WithAggregator(func(res any, err error) {
+			if err != nil || res == nil {
+				return
+			}
+			for _, hit := range res.(*search.BrowseResponse).Hits {
+				objectIDs[hit.ObjectID] = true
+			}
+		}),
+	)
+	return objectIDs, err
+}
+
+func hasPromotions(rules []search.Rule) bool {
+	for _, rule := range rules {
+		if len(rule.Consequence.Promote) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeNames returns the names of the attributes of a setting, without their modifiers.
+// It never returns nil, so that the checks using them aren't skipped.
+func attributeNames(attributes []string) []string {
+	names := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		names = append(names, lint.AttributeName(a))
+	}
+	return names
+}
@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const rulesFile = `{"objectID":"a","conditions":[{"pattern":"sale","anchoring":"is"}],"consequence":{"params":{"filters":"brand:Apple"}}}
+{"objectID":"old","consequence":{"hide":[{"objectID":"1"}]},"validity":[{"from":1000,"until":1704067200}]}
+`
+
+func Test_runLintCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		isTTY   bool
+		index   bool
+		wantOut string
+		wantErr error
+	}{
+		{
+			name:  "index",
+			cli:   "MOVIES",
+			isTTY: true,
+			index: true,
+			wantOut: "SEVERITY  RULE  CHECK                     MESSAGE\n" +
+				"error     a     duplicate-conditions      same conditions as b\n" +
+				"error     b     duplicate-conditions      same conditions as a\n" +
+				"error     b     missing-promoted-object   promoted records don't exist: 2\n" +
+				"warning   b     unknown-filter-attribute  unknown attributes in filter...\n" +
+				"\nFound 4 issues in 2 rules\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name:    "file without index, warnings don't fail",
+			cli:     "-F -",
+			stdin:   rulesFile,
+			wantOut: "warning\told\texpired\tall the validity windows have passed, the last one ended on 2024-01-01T00:00:00Z\n",
+		},
+		{
+			name:    "fail on warnings",
+			cli:     "-F - --fail-on warning -o json",
+			stdin:   rulesFile,
+			wantOut: "[{\"severity\":\"warning\",\"objectID\":\"old\",\"check\":\"expired\",\"message\":\"all the validity windows have passed, the last one ended on 2024-01-01T00:00:00Z\"}]\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name:    "no issues",
+			cli:     "-F - --fail-on info",
+			stdin:   `{"objectID":"a","consequence":{"hide":[{"objectID":"1"}]}}`,
+			isTTY:   true,
+			wantOut: "✓ No issues found in 1 rule\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.index {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/rules/search"),
+					httpmock.StringResponse(`{"hits":[
+						{"objectID":"a","conditions":[{"pattern":"sale","anchoring":"is"}],"consequence":{"params":{"filters":"brand:Apple"}}},
+						{"objectID":"b","conditions":[{"pattern":"sale","anchoring":"is"}],"consequence":{"promote":[{"objectID":"1","position":0},{"objectID":"2","position":1}],"params":{"filters":"colour:red"}}}
+					],"nbHits":2,"page":0,"nbPages":1}`),
+				)
+				r.Register(
+					httpmock.REST("GET", "1/indexes/MOVIES/settings"),
+					httpmock.JSONResponse(search.SettingsResponse{
+						AttributesForFaceting: []string{"searchable(brand)", "color"},
+					}),
+				)
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/browse"),
+					httpmock.StringResponse(`{"hits":[{"objectID":"1"},{"objectID":"3"}],"page":0,"nbHits":2,"nbPages":1,"hitsPerPage":1000,"processingTimeMS":1,"exhaustiveNbHits":true,"query":"","params":""}`),
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, tt.stdin)
+			cmd := NewLintCmd(f, func(opts *LintOptions) error {
+				opts.Now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+				return runLintCmd(opts)
+			})
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_NewLintCmd_missingInput(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewLintCmd(f, nil)
+	_, err := test.Execute(cmd, "", out)
+	assert.EqualError(t, err, "an index or a file (-F) is required")
+}
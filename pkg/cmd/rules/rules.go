@@ -5,7 +5,14 @@ import (
 
 	"github.com/algolia/cli/pkg/cmd/rules/browse"
 	"github.com/algolia/cli/pkg/cmd/rules/delete"
+	"github.com/algolia/cli/pkg/cmd/rules/enable"
+	"github.com/algolia/cli/pkg/cmd/rules/explain"
+	"github.com/algolia/cli/pkg/cmd/rules/get"
 	importRules "github.com/algolia/cli/pkg/cmd/rules/import"
+	"github.com/algolia/cli/pkg/cmd/rules/lint"
+	"github.com/algolia/cli/pkg/cmd/rules/save"
+	"github.com/algolia/cli/pkg/cmd/rules/schedule"
+	searchRules "github.com/algolia/cli/pkg/cmd/rules/search"
 	"github.com/algolia/cli/pkg/cmdutil"
 )
 
@@ -20,6 +27,14 @@ func NewRulesCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(importRules.NewImportCmd(f, nil))
 	cmd.AddCommand(browse.NewBrowseCmd(f))
 	cmd.AddCommand(delete.NewDeleteCmd(f, nil))
+	cmd.AddCommand(save.NewSaveCmd(f, nil))
+	cmd.AddCommand(get.NewGetCmd(f, nil))
+	cmd.AddCommand(searchRules.NewSearchCmd(f, nil))
+	cmd.AddCommand(enable.NewEnableCmd(f, nil))
+	cmd.AddCommand(enable.NewDisableCmd(f, nil))
+	cmd.AddCommand(schedule.NewScheduleCmd(f, nil))
+	cmd.AddCommand(lint.NewLintCmd(f, nil))
+	cmd.AddCommand(explain.NewExplainCmd(f, nil))
 
 	return cmd
 }
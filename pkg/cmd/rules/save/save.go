@@ -0,0 +1,233 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/cmd/shared/handler"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type SaveOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index             string
+	Rule              search.Rule
+	ForwardToReplicas bool
+	Wait              bool
+
+	// UseEditor opens the rule in the user's editor before saving it.
+	// If no rule attributes are given as flags, the editor starts with the existing rule.
+	UseEditor     bool
+	RuleFromFlags bool
+	Edit          func(content []byte) ([]byte, error)
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewSaveCmd creates and returns a save command for index rules
+func NewSaveCmd(f *cmdutil.Factory, runF func(*SaveOptions) error) *cobra.Command {
+	opts := &SaveOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+		Edit: func(content []byte) ([]byte, error) {
+			return cmdutil.Edit(f.IOStreams, content, "rule-*.json")
+		},
+	}
+
+	flags := &shared.RuleFlags{}
+
+	cmd := &cobra.Command{
+		Use:               "save <index> --id <id> [--pattern <pattern>] [--promote <objectID:position>...] [--hide <objectID>...] [--editor]",
+		Args:              validators.ExactArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "editSettings",
+		},
+		Short:   "Add or update a rule of an index",
+		Aliases: []string{"create", "edit"},
+		Long: heredoc.Doc(`
+			This command adds a rule to the specified index.
+			If a rule with the same ID exists, it's replaced.
+
+			The rule is built from the flags. In an interactive terminal, you're asked for the missing ones.
+			With --editor, the rule opens as JSON in your editor ($VISUAL or $EDITOR) before it's saved.
+			If only --id is given, the editor starts with the existing rule, so you can edit it.
+		`),
+		Example: heredoc.Doc(`
+			# Promote the record "42" at the first position when the query is "star wars", in the "MOVIES" index
+			$ algolia rules save MOVIES --id star-wars --pattern "star wars" --anchoring is --promote 42:0
+
+			# Hide records and filter the results when the query contains "kids", only in December 2025
+			$ algolia rules save MOVIES --id kids --pattern kids --hide 13,666 --filters "rating:G" --validity 2025-12-01/2026-01-01
+
+			# Set search parameters when the search has the "mobile" rule context
+			$ algolia rules save MOVIES --id mobile --context mobile --params '{"hitsPerPage": 5}'
+
+			# Edit the existing rule "kids" in your editor
+			$ algolia rules save MOVIES --id kids --editor
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+
+			if opts.UseEditor {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("--editor requires an interactive terminal")
+				}
+				for _, name := range []string{"description", "pattern", "anchoring", "alternatives", "context", "validity", "promote", "hide", "filters", "params"} {
+					opts.RuleFromFlags = opts.RuleFromFlags || cmd.Flags().Changed(name)
+				}
+			} else {
+				flagsHandler := &handler.RuleHandler{
+					Flags: flags,
+					Cmd:   cmd,
+				}
+				if err := handler.HandleFlags(flagsHandler, opts.IO.CanPrompt()); err != nil {
+					return err
+				}
+			}
+
+			rule, err := shared.FlagsToRule(*flags)
+			if err != nil {
+				return cmdutil.FlagErrorf("%s", err)
+			}
+			opts.Rule = *rule
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSaveCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.RuleID, "id", "i", "", "Rule ID to save")
+	cmd.Flags().StringVarP(&flags.Description, "description", "d", "", "Description of the rule")
+	// Conditions
+	cmd.Flags().StringVar(&flags.Pattern, "pattern", "", "Query pattern that triggers the rule")
+	cmd.Flags().
+		StringVarP(&flags.Anchoring, "anchoring", "a", "", "How the query must match the pattern: is, startsWith, endsWith or contains (default contains)")
+	_ = cmd.RegisterFlagCompletionFunc("anchoring", cmdutil.StringCompletionFunc(map[string]string{
+		"is":         "The query must be exactly the pattern",
+		"startsWith": "The query must start with the pattern",
+		"endsWith":   "The query must end with the pattern",
+		"contains":   "The query must contain the pattern",
+	}))
+	cmd.Flags().
+		BoolVar(&flags.Alternatives, "alternatives", false, "Whether the pattern matches plurals, synonyms and typos")
+	cmd.Flags().
+		StringVarP(&flags.Context, "context", "c", "", "Rule context that triggers the rule (see the ruleContexts search parameter)")
+	cmd.Flags().
+		StringSliceVar(&flags.Validity, "validity", nil, "Time windows when the rule is active, as `from/until` dates (RFC 3339, YYYY-MM-DD or Unix timestamps)")
+	// Consequences
+	cmd.Flags().
+		StringSliceVar(&flags.Promotions, "promote", nil, "Records to promote, as `objectID:position`")
+	cmd.Flags().StringSliceVar(&flags.Hides, "hide", nil, "IDs of the records to hide")
+	cmd.Flags().StringVar(&flags.Filters, "filters", "", "Filters to apply to the search")
+	cmd.Flags().
+		StringVar(&flags.Params, "params", "", "Search parameters to apply, as a JSON object")
+
+	cmd.Flags().BoolVarP(&opts.UseEditor, "editor", "e", false, "Edit the rule in your editor before saving it")
+	cmd.Flags().
+		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", true, "Whether to save the rule on the replicas")
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runSaveCmd(opts *SaveOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	rule := opts.Rule
+	if opts.UseEditor {
+		edited, err := editRule(client, opts)
+		if err != nil {
+			return err
+		}
+		rule = *edited
+	}
+
+	if err := shared.ValidateRule(rule); err != nil {
+		return err
+	}
+
+	res, err := client.SaveRule(
+		client.NewApiSaveRuleRequest(opts.Index, rule.ObjectID, &rule).
+			WithForwardToReplicas(opts.ForwardToReplicas),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rule: %w", err)
+	}
+	if opts.Wait {
+		_, err := client.WaitForTask(opts.Index, res.TaskID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.Index, TaskID: res.TaskID}})
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Rule '%s' saved to %s\n", cs.SuccessIcon(), rule.ObjectID, opts.Index)
+	}
+
+	return nil
+}
+
+// editRule opens the rule in the editor, and returns the edited rule.
+func editRule(client *search.APIClient, opts *SaveOptions) (*search.Rule, error) {
+	rule := opts.Rule
+	if !opts.RuleFromFlags && rule.ObjectID != "" {
+		existing, err := client.GetRule(client.NewApiGetRuleRequest(opts.Index, rule.ObjectID))
+		var apiErr *search.APIError
+		switch {
+		case err == nil:
+			rule = *existing
+		case errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound:
+			// New rule
+		default:
+			return nil, fmt.Errorf("failed to get rule: %w", err)
+		}
+	}
+
+	b, err := json.MarshalIndent(rule, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	b, err = opts.Edit(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var edited search.Rule
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&edited); err != nil {
+		return nil, fmt.Errorf("failed to parse the edited rule: %w", err)
+	}
+	return &edited, nil
+}
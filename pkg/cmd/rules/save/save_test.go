@@ -0,0 +1,191 @@
+package save
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runSaveCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		wantOut string
+		wantErr string
+		// noReplicas is true if the rule shouldn't be forwarded to the replicas
+		noReplicas bool
+	}{
+		{
+			name:  "from flags, no TTY",
+			cli:   "MOVIES --id 1 --pattern 'star wars' --promote 42:0",
+			isTTY: false,
+		},
+		{
+			name:    "from flags, TTY",
+			cli:     "MOVIES -i 1 --pattern 'star wars' -a is --hide 13",
+			isTTY:   true,
+			wantOut: "✓ Rule '1' saved to MOVIES\n",
+		},
+		{
+			name:    "JSON output",
+			cli:     "MOVIES --id 1 --filters rating:G -o json",
+			wantOut: "[{\"index\":\"MOVIES\",\"taskID\":0}]\n",
+		},
+		{
+			name:       "without replicas",
+			cli:        "MOVIES --id 1 --hide 13 -f=false",
+			noReplicas: true,
+		},
+		{
+			name:    "missing id",
+			cli:     "MOVIES --promote 42:0",
+			wantErr: "a unique rule id is required",
+		},
+		{
+			name:    "missing consequence",
+			cli:     "MOVIES --id 1 --pattern kids",
+			wantErr: "at least 1 consequence is required (--promote, --hide, --filters or --params)",
+		},
+		{
+			name:    "invalid promotion",
+			cli:     "MOVIES --id 1 --promote 42",
+			wantErr: `invalid promotion "42", expected objectID:position`,
+		},
+		{
+			name:    "editor without TTY",
+			cli:     "MOVIES --id 1 --editor",
+			wantErr: "--editor requires an interactive terminal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.wantErr == "" {
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/MOVIES/rules/1"),
+					func(req *http.Request) (*http.Response, error) {
+						assert.Equal(t, strconv.FormatBool(!tt.noReplicas), req.URL.Query().Get("forwardToReplicas"))
+						return httpmock.JSONResponse(search.UpdatedAtWithObjectIdResponse{})(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewSaveCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_runSaveCmd_editor(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		existing    bool
+		wantEditor  string
+		edited      string
+		wantErr     string
+		wantOut     string
+		wantsSaving bool
+	}{
+		{
+			name:        "edit an existing rule",
+			cli:         "MOVIES --id 1 --editor",
+			existing:    true,
+			wantEditor:  `{"objectID":"1","consequence":{"hide":[{"objectID":"13"}]}}`,
+			edited:      `{"objectID":"1","consequence":{"hide":[{"objectID":"13"},{"objectID":"666"}]}}`,
+			wantOut:     "✓ Rule '1' saved to MOVIES\n",
+			wantsSaving: true,
+		},
+		{
+			name:        "new rule",
+			cli:         "MOVIES --id 1 --editor",
+			wantEditor:  `{"objectID":"1","consequence":{}}`,
+			edited:      `{"objectID":"1","consequence":{"promote":[{"objectID":"42","position":0}]}}`,
+			wantOut:     "✓ Rule '1' saved to MOVIES\n",
+			wantsSaving: true,
+		},
+		{
+			name:       "rule from flags",
+			cli:        "MOVIES --id 1 --pattern kids --editor",
+			wantEditor: `{"objectID":"1","conditions":[{"pattern":"kids","anchoring":"contains"}],"consequence":{}}`,
+			edited:     `{"objectID":"1","conditions":[{"pattern":"kids","anchoring":"contains"}],"consequence":{}}`,
+			wantErr:    "at least 1 consequence is required (promotions, hides, filters, params or user data)",
+		},
+		{
+			name:       "unknown attribute",
+			cli:        "MOVIES --id 1 --filters rating:G --editor",
+			wantEditor: `{"objectID":"1","consequence":{"params":{"filters":"rating:G"}}}`,
+			edited:     `{"objectID":"1","consequences":{}}`,
+			wantErr:    `failed to parse the edited rule: json: unknown field "consequences"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.cli == "MOVIES --id 1 --editor" {
+				if tt.existing {
+					r.Register(
+						httpmock.REST("GET", "1/indexes/MOVIES/rules/1"),
+						httpmock.JSONResponse(search.Rule{
+							ObjectID: "1",
+							Consequence: search.Consequence{
+								Hide: []search.ConsequenceHide{{ObjectID: "13"}},
+							},
+						}),
+					)
+				} else {
+					r.Register(
+						httpmock.REST("GET", "1/indexes/MOVIES/rules/1"),
+						httpmock.ErrorResponse(),
+					)
+				}
+			}
+			if tt.wantsSaving {
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/MOVIES/rules/1"),
+					httpmock.JSONResponse(search.UpdatedAtWithObjectIdResponse{}),
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(true, &r, nil, "")
+			var opts *SaveOptions
+			cmd := NewSaveCmd(f, func(o *SaveOptions) error {
+				opts = o
+				return nil
+			})
+			_, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+
+			opts.Edit = func(content []byte) ([]byte, error) {
+				assert.JSONEq(t, tt.wantEditor, string(content))
+				return []byte(tt.edited), nil
+			}
+			err = runSaveCmd(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
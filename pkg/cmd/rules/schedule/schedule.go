@@ -0,0 +1,185 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type ScheduleOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index             string
+	RuleID            string
+	From              int64
+	Until             int64
+	Append            bool
+	Clear             bool
+	ForwardToReplicas bool
+	Wait              bool
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewScheduleCmd creates and returns a schedule command for index rules
+func NewScheduleCmd(f *cmdutil.Factory, runF func(*ScheduleOptions) error) *cobra.Command {
+	opts := &ScheduleOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var from, until string
+
+	cmd := &cobra.Command{
+		Use:               "schedule <index> <rule-id> --from <time> --until <time>",
+		Args:              validators.ExactArgs(2),
+		ValidArgsFunction: cmdutil.RuleIDs(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "settings,editSettings",
+		},
+		Short: "Set when a rule of an index is active.",
+		Long: heredoc.Doc(`
+			This command sets the validity of a rule: the time range when it's active.
+			Dates are RFC 3339 timestamps (2006-01-02T15:04:05Z), dates (2006-01-02) or Unix timestamps.
+
+			By default, the time range replaces the existing ones. Use --append to add it to them,
+			or --clear to remove all the time ranges so that the rule is always active.
+			The other attributes of the rule don't change.
+		`),
+		Example: heredoc.Doc(`
+			# Activate the rule "black-friday" of the "PRODUCTS" index from November 28 to December 1, 2025
+			$ algolia rules schedule PRODUCTS black-friday --from 2025-11-28 --until 2025-12-01
+
+			# Also activate it during Cyber Monday
+			$ algolia rules schedule PRODUCTS black-friday --from 2025-12-01T00:00:00Z --until 2025-12-02T00:00:00Z --append
+
+			# Make the rule always active
+			$ algolia rules schedule PRODUCTS black-friday --clear
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			opts.RuleID = args[1]
+
+			if err := cmdutil.MutuallyExclusive(
+				"--clear can't be used with --from, --until or --append",
+				opts.Clear,
+				from != "" || until != "" || opts.Append,
+			); err != nil {
+				return err
+			}
+
+			if !opts.Clear {
+				if from == "" || until == "" {
+					return cmdutil.FlagErrorf("--from and --until are required (or --clear)")
+				}
+				var err error
+				if opts.From, err = shared.ParseTime(from); err != nil {
+					return cmdutil.FlagErrorf("invalid --from: %s", err)
+				}
+				if opts.Until, err = shared.ParseTime(until); err != nil {
+					return cmdutil.FlagErrorf("invalid --until: %s", err)
+				}
+				if opts.Until <= opts.From {
+					return cmdutil.FlagErrorf("--until must be after --from")
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runScheduleCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "When the rule starts to be active")
+	cmd.Flags().StringVar(&until, "until", "", "When the rule stops to be active")
+	cmd.Flags().
+		BoolVar(&opts.Append, "append", false, "Add the time range to the existing ones instead of replacing them")
+	cmd.Flags().
+		BoolVar(&opts.Clear, "clear", false, "Remove all the time ranges, so that the rule is always active")
+	cmd.Flags().
+		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", true, "Whether to update the rule on replica indices")
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runScheduleCmd(opts *ScheduleOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Updating rule")
+	rule, err := shared.GetRawRule(client, opts.Index, opts.RuleID)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	if opts.Clear {
+		delete(rule, "validity")
+	} else {
+		var validity []interface{}
+		if opts.Append {
+			validity, _ = rule["validity"].([]interface{})
+		}
+		rule["validity"] = append(validity, map[string]interface{}{
+			"from":  opts.From,
+			"until": opts.Until,
+		})
+	}
+
+	taskID, err := shared.SaveRawRule(client, opts.Index, rule, opts.ForwardToReplicas)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	if opts.Wait {
+		if _, err := client.WaitForTask(opts.Index, taskID); err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.Index, TaskID: taskID}})
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		if opts.Clear {
+			fmt.Fprintf(opts.IO.Out, "%s Rule '%s' on %s is now always active\n", cs.SuccessIcon(), opts.RuleID, opts.Index)
+		} else {
+			fmt.Fprintf(
+				opts.IO.Out,
+				"%s Rule '%s' on %s is active from %s until %s\n",
+				cs.SuccessIcon(),
+				opts.RuleID,
+				opts.Index,
+				time.Unix(opts.From, 0).UTC().Format(time.RFC3339),
+				time.Unix(opts.Until, 0).UTC().Format(time.RFC3339),
+			)
+		}
+	}
+
+	return nil
+}
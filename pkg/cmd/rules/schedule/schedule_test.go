@@ -0,0 +1,97 @@
+package schedule
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runScheduleCmd(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wantBody string
+		wantOut  string
+		wantErr  string
+	}{
+		{
+			name:     "replace the validity",
+			cli:      "PRODUCTS 1 --from 2025-11-28 --until 2025-12-01",
+			wantBody: `{"objectID":"1","consequence":{},"description":"Black Friday","validity":[{"from":1764288000,"until":1764547200}]}`,
+			wantOut:  "✓ Rule '1' on PRODUCTS is active from 2025-11-28T00:00:00Z until 2025-12-01T00:00:00Z\n",
+		},
+		{
+			name:     "append to the validity",
+			cli:      "PRODUCTS 1 --from 1764547200 --until 2025-12-02T00:00:00Z --append",
+			wantBody: `{"objectID":"1","consequence":{},"description":"Black Friday","validity":[{"from":1000,"until":2000},{"from":1764547200,"until":1764633600}]}`,
+			wantOut:  "✓ Rule '1' on PRODUCTS is active from 2025-12-01T00:00:00Z until 2025-12-02T00:00:00Z\n",
+		},
+		{
+			name:     "clear the validity",
+			cli:      "PRODUCTS 1 --clear",
+			wantBody: `{"objectID":"1","consequence":{},"description":"Black Friday"}`,
+			wantOut:  "✓ Rule '1' on PRODUCTS is now always active\n",
+		},
+		{
+			name:    "missing until",
+			cli:     "PRODUCTS 1 --from 2025-11-28",
+			wantErr: "--from and --until are required (or --clear)",
+		},
+		{
+			name:    "until before from",
+			cli:     "PRODUCTS 1 --from 2025-12-01 --until 2025-11-28",
+			wantErr: "--until must be after --from",
+		},
+		{
+			name:    "invalid date",
+			cli:     "PRODUCTS 1 --from tomorrow --until 2025-11-28",
+			wantErr: `invalid --from: "tomorrow" isn't a date`,
+		},
+		{
+			name:    "clear with dates",
+			cli:     "PRODUCTS 1 --clear --from 2025-11-28",
+			wantErr: "--clear can't be used with --from, --until or --append",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.wantErr == "" {
+				r.Register(
+					httpmock.REST("GET", "1/indexes/PRODUCTS/rules/1"),
+					httpmock.StringResponse(
+						`{"objectID":"1","consequence":{},"description":"Black Friday","validity":[{"from":1000,"until":2000}]}`,
+					),
+				)
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/PRODUCTS/rules/1"),
+					func(req *http.Request) (*http.Response, error) {
+						body, err := io.ReadAll(req.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, tt.wantBody, string(body))
+						assert.Equal(t, "true", req.URL.Query().Get("forwardToReplicas"))
+						return httpmock.StringResponse(`{"objectID":"1","taskID":1,"updatedAt":"2025-01-01T00:00:00Z"}`)(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(true, &r, nil, "")
+			cmd := NewScheduleCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
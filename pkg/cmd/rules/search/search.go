@@ -0,0 +1,192 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	algoliaSearch "github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type SearchOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*algoliaSearch.APIClient, error)
+
+	Index  string
+	Params *algoliaSearch.SearchRulesParams
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewSearchCmd creates and returns a search command for index rules
+func NewSearchCmd(f *cmdutil.Factory, runF func(*SearchOptions) error) *cobra.Command {
+	opts := &SearchOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var (
+		query, anchoring, context string
+		enabled                   bool
+		page, hitsPerPage         int32
+	)
+
+	cmd := &cobra.Command{
+		Use:               "search <index> [--query <query>] [--anchoring <anchoring>] [--context <context>] [--enabled]",
+		Args:              validators.ExactArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"runInWebCLI": "true",
+			"acls":        "settings",
+		},
+		Short: "Search the rules of an index.",
+		Long: heredoc.Doc(`
+			This command searches the rules of the specified index.
+			Results are paginated: use --page and --hits-per-page to go through them.
+		`),
+		Example: heredoc.Doc(`
+			# Search the rules of the "MOVIES" index matching "star wars"
+			$ algolia rules search MOVIES --query "star wars"
+
+			# Search the disabled rules of the "MOVIES" index for the "mobile" context
+			$ algolia rules search MOVIES --context mobile --enabled=false
+
+			# Get the second page of the rules with an "is" anchoring, as JSON
+			$ algolia rules search MOVIES --anchoring is --page 1 -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+
+			if page < 0 {
+				return cmdutil.FlagErrorf("--page must be a positive integer")
+			}
+			if hitsPerPage < 1 || hitsPerPage > 1000 {
+				return cmdutil.FlagErrorf("--hits-per-page must be between 1 and 1000")
+			}
+
+			params := algoliaSearch.NewEmptySearchRulesParams().
+				SetQuery(query).
+				SetPage(page).
+				SetHitsPerPage(hitsPerPage)
+			if anchoring != "" {
+				a, err := algoliaSearch.NewAnchoringFromValue(anchoring)
+				if err != nil {
+					return cmdutil.FlagErrorf(
+						"invalid anchoring %q, expected one of: %s",
+						anchoring,
+						strings.Join(shared.AnchoringValues(), ", "),
+					)
+				}
+				params.SetAnchoring(*a)
+			}
+			if context != "" {
+				params.SetContext(context)
+			}
+			// Without the flag, both enabled and disabled rules are returned
+			if cmd.Flags().Changed("enabled") {
+				params.SetEnabled(enabled)
+			}
+			opts.Params = params
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSearchCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&query, "query", "q", "", "Search query for the rules")
+	cmd.Flags().
+		StringVarP(&anchoring, "anchoring", "a", "", "Only return rules with this anchoring: is, startsWith, endsWith or contains")
+	_ = cmd.RegisterFlagCompletionFunc("anchoring", cmdutil.StringCompletionFunc(map[string]string{
+		"is":         "The query must be exactly the pattern",
+		"startsWith": "The query must start with the pattern",
+		"endsWith":   "The query must end with the pattern",
+		"contains":   "The query must contain the pattern",
+	}))
+	cmd.Flags().StringVarP(&context, "context", "c", "", "Only return rules with this context")
+	cmd.Flags().
+		BoolVar(&enabled, "enabled", false, "Only return enabled rules (--enabled=false for disabled rules)")
+	cmd.Flags().Int32Var(&page, "page", 0, "Page of results to return (starting at 0)")
+	cmd.Flags().Int32Var(&hitsPerPage, "hits-per-page", 20, "Number of rules per page")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runSearchCmd(opts *SearchOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Searching rules")
+	res, err := client.SearchRules(
+		client.NewApiSearchRulesRequest(opts.Index).WithSearchRulesParams(opts.Params),
+	)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, res)
+	}
+
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("ID", nil, nil)
+		table.AddField("DESCRIPTION", nil, nil)
+		table.AddField("CONDITIONS", nil, nil)
+		table.AddField("ENABLED", nil, nil)
+		table.EndRow()
+	}
+
+	for _, rule := range res.Hits {
+		description := ""
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+		enabled := rule.Enabled == nil || *rule.Enabled
+
+		table.AddField(rule.ObjectID, nil, nil)
+		table.AddField(description, nil, nil)
+		table.AddField(shared.FormatConditions(rule.Conditions), nil, nil)
+		table.AddField(fmt.Sprint(enabled), nil, nil)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(
+			opts.IO.Out,
+			"Page %d of %d (%s)\n",
+			res.Page+1,
+			max(res.NbPages, 1),
+			utils.Pluralize(int(res.NbHits), "rule"),
+		)
+	}
+
+	return nil
+}
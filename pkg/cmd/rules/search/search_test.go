@@ -0,0 +1,105 @@
+package search
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	algoliaSearch "github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runSearchCmd(t *testing.T) {
+	pattern, context, description := "star wars", "mobile", "Promote the saga"
+	anchoring := algoliaSearch.ANCHORING_IS
+	disabled := false
+	res := algoliaSearch.SearchRulesResponse{
+		Hits: []algoliaSearch.Rule{
+			{
+				ObjectID:    "1",
+				Description: &description,
+				Conditions: []algoliaSearch.Condition{
+					{Pattern: &pattern, Anchoring: &anchoring, Context: &context},
+				},
+			},
+			{ObjectID: "2", Enabled: &disabled},
+		},
+		NbHits:  22,
+		Page:    1,
+		NbPages: 2,
+	}
+
+	tests := []struct {
+		name       string
+		cli        string
+		isTTY      bool
+		wantParams string
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:       "TTY",
+			cli:        "MOVIES --query star --page 1",
+			isTTY:      true,
+			wantParams: `{"query":"star","page":1,"hitsPerPage":20}`,
+			wantOut: "ID  DESCRIPTION       CONDITIONS               ENABLED\n" +
+				"1   Promote the saga  is \"star wars\" (mobile)  true\n" +
+				"2                                              false\n" +
+				"Page 2 of 2 (22 rules)\n",
+		},
+		{
+			name:       "no TTY with filters",
+			cli:        "MOVIES -a is -c mobile --enabled=false --hits-per-page 2",
+			wantParams: `{"query":"","anchoring":"is","context":"mobile","page":0,"hitsPerPage":2,"enabled":false}`,
+			wantOut:    "1\tPromote the saga\tis \"star wars\" (mobile)\ttrue\n2\t\t\tfalse\n",
+		},
+		{
+			name:       "JSON output",
+			cli:        "MOVIES --enabled -o json",
+			wantParams: `{"query":"","page":0,"hitsPerPage":20,"enabled":true}`,
+			wantOut:    "{\"hits\":[{\"conditions\":[{\"anchoring\":\"is\",\"context\":\"mobile\",\"pattern\":\"star wars\"}],\"consequence\":{},\"description\":\"Promote the saga\",\"objectID\":\"1\"},{\"consequence\":{},\"enabled\":false,\"objectID\":\"2\"}],\"nbHits\":22,\"nbPages\":2,\"page\":1}\n",
+		},
+		{
+			name:    "invalid anchoring",
+			cli:     "MOVIES --anchoring equals",
+			wantErr: `invalid anchoring "equals", expected one of: is, startsWith, endsWith, contains`,
+		},
+		{
+			name:    "invalid hits per page",
+			cli:     "MOVIES --hits-per-page 0",
+			wantErr: "--hits-per-page must be between 1 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.wantErr == "" {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/rules/search"),
+					func(req *http.Request) (*http.Response, error) {
+						body, err := io.ReadAll(req.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, tt.wantParams, string(body))
+						return httpmock.JSONResponse(res)(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewSearchCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// FormatConditions summarizes the conditions of a rule, for example: `is "star wars" (mobile)`.
+func FormatConditions(conditions []search.Condition) string {
+	summaries := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		var parts []string
+		if c.Pattern != nil {
+			anchoring := ""
+			if c.Anchoring != nil {
+				anchoring = string(*c.Anchoring) + " "
+			}
+			parts = append(parts, fmt.Sprintf("%s%q", anchoring, *c.Pattern))
+		}
+		if c.Filters != nil {
+			parts = append(parts, fmt.Sprintf("filters %q", *c.Filters))
+		}
+		if c.Context != nil {
+			parts = append(parts, fmt.Sprintf("(%s)", *c.Context))
+		}
+		summaries = append(summaries, strings.Join(parts, " "))
+	}
+	return strings.Join(summaries, ", ")
+}
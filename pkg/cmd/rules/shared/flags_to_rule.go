@@ -0,0 +1,187 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+type RuleFlags struct {
+	RuleID       string
+	Description  string
+	Pattern      string
+	Anchoring    string
+	Alternatives bool
+	Context      string
+	Validity     []string
+	Promotions   []string
+	Hides        []string
+	Filters      string
+	Params       string
+}
+
+// AnchoringValues are the allowed values of the anchoring of a rule condition.
+func AnchoringValues() []string {
+	values := make([]string, 0, len(search.AllowedAnchoringEnumValues))
+	for _, a := range search.AllowedAnchoringEnumValues {
+		values = append(values, string(a))
+	}
+	return values
+}
+
+// FlagsToRule builds a rule from the flags of the `rules save` command.
+func FlagsToRule(flags RuleFlags) (*search.Rule, error) {
+	rule := search.NewEmptyRule().SetObjectID(flags.RuleID)
+	if flags.Description != "" {
+		rule.SetDescription(flags.Description)
+	}
+
+	if flags.Pattern != "" || flags.Anchoring != "" || flags.Context != "" {
+		condition := search.NewEmptyCondition()
+		if flags.Pattern != "" || flags.Anchoring != "" {
+			anchoring := flags.Anchoring
+			if anchoring == "" {
+				anchoring = string(search.ANCHORING_CONTAINS)
+			}
+			a, err := search.NewAnchoringFromValue(anchoring)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"invalid anchoring %q, expected one of: %s",
+					flags.Anchoring,
+					strings.Join(AnchoringValues(), ", "),
+				)
+			}
+			condition.SetPattern(flags.Pattern).SetAnchoring(*a)
+			if flags.Alternatives {
+				condition.SetAlternatives(true)
+			}
+		}
+		if flags.Context != "" {
+			condition.SetContext(flags.Context)
+		}
+		rule.SetConditions([]search.Condition{*condition})
+	}
+
+	for _, v := range flags.Validity {
+		timeRange, err := ParseValidity(v)
+		if err != nil {
+			return nil, err
+		}
+		rule.Validity = append(rule.Validity, *timeRange)
+	}
+
+	consequence := search.NewEmptyConsequence()
+	for _, p := range flags.Promotions {
+		promote, err := ParsePromotion(p)
+		if err != nil {
+			return nil, err
+		}
+		consequence.Promote = append(consequence.Promote, *promote)
+	}
+	for _, objectID := range flags.Hides {
+		consequence.Hide = append(consequence.Hide, *search.NewEmptyConsequenceHide().SetObjectID(objectID))
+	}
+	if flags.Params != "" {
+		params := search.NewEmptyConsequenceParams()
+		decoder := json.NewDecoder(bytes.NewReader([]byte(flags.Params)))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(params); err != nil {
+			return nil, fmt.Errorf("invalid params: %w", err)
+		}
+		consequence.SetParams(params)
+	}
+	if flags.Filters != "" {
+		if consequence.Params == nil {
+			consequence.SetParams(search.NewEmptyConsequenceParams())
+		}
+		consequence.Params.SetFilters(flags.Filters)
+	}
+	rule.SetConsequence(consequence)
+
+	return rule, nil
+}
+
+// ParsePromotion parses a promotion in the format `objectID:position`.
+func ParsePromotion(s string) (*search.Promote, error) {
+	i := strings.LastIndex(s, ":")
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid promotion %q, expected objectID:position", s)
+	}
+	position, err := strconv.ParseInt(s[i+1:], 10, 32)
+	if err != nil || position < 0 {
+		return nil, fmt.Errorf("invalid position in promotion %q, expected a positive integer", s)
+	}
+	return search.PromoteObjectIDAsPromote(
+		search.NewEmptyPromoteObjectID().SetObjectID(s[:i]).SetPosition(int32(position)),
+	), nil
+}
+
+// ParseValidity parses a validity window in the format `from/until`.
+// Both dates are either RFC 3339 timestamps (2006-01-02T15:04:05Z), dates (2006-01-02) or Unix timestamps.
+func ParseValidity(s string) (*search.TimeRange, error) {
+	from, until, ok := strings.Cut(s, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid validity %q, expected from/until", s)
+	}
+	f, err := ParseTime(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start of validity %q: %w", s, err)
+	}
+	u, err := ParseTime(until)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end of validity %q: %w", s, err)
+	}
+	if u <= f {
+		return nil, fmt.Errorf("invalid validity %q, the end must be after the start", s)
+	}
+	return search.NewEmptyTimeRange().SetFrom(f).SetUntil(u), nil
+}
+
+// ParseTime parses a date as an RFC 3339 timestamp, a YYYY-MM-DD date or a Unix timestamp.
+func ParseTime(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ts, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	return 0, fmt.Errorf("%q isn't a date", s)
+}
+
+// ValidateRule checks that a rule can be saved: it must have an ID and a consequence,
+// and the anchoring of its conditions must be valid.
+func ValidateRule(rule search.Rule) error {
+	if rule.ObjectID == "" {
+		return fmt.Errorf("a rule id is required")
+	}
+	for _, c := range rule.Conditions {
+		if c.Anchoring != nil && !c.Anchoring.IsValid() {
+			return fmt.Errorf(
+				"invalid anchoring %q, expected one of: %s",
+				*c.Anchoring,
+				strings.Join(AnchoringValues(), ", "),
+			)
+		}
+		if c.Pattern != nil && c.Anchoring == nil {
+			return fmt.Errorf("an anchoring is required with a pattern")
+		}
+	}
+	c := rule.Consequence
+	if c.Params == nil && len(c.Promote) == 0 && len(c.Hide) == 0 && c.UserData == nil {
+		return fmt.Errorf("at least 1 consequence is required (promotions, hides, filters, params or user data)")
+	}
+	for _, v := range rule.Validity {
+		if v.Until <= v.From {
+			return fmt.Errorf("invalid validity, the end must be after the start")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FlagsToRule(t *testing.T) {
+	tests := []struct {
+		name        string
+		ruleFlags   RuleFlags
+		wantsRule   string
+		wantsErrMsg string
+	}{
+		{
+			name: "promotion with pattern",
+			ruleFlags: RuleFlags{
+				RuleID:     "1",
+				Pattern:    "star wars",
+				Anchoring:  "is",
+				Promotions: []string{"42:0", "a:b:3"},
+			},
+			wantsRule: `{"objectID":"1","conditions":[{"pattern":"star wars","anchoring":"is"}],"consequence":{"promote":[{"objectID":"42","position":0},{"objectID":"a:b","position":3}]}}`,
+		},
+		{
+			name: "default anchoring, context and validity",
+			ruleFlags: RuleFlags{
+				RuleID:      "1",
+				Description: "Kids",
+				Pattern:     "kids",
+				Context:     "mobile",
+				Validity:    []string{"2025-12-01/2026-01-01", "1767225600/1767312000"},
+				Hides:       []string{"13"},
+				Filters:     "rating:G",
+				Params:      `{"hitsPerPage": 5}`,
+			},
+			wantsRule: `{"objectID":"1","conditions":[{"pattern":"kids","anchoring":"contains","context":"mobile"}],"consequence":{"params":{"filters":"rating:G","hitsPerPage":5},"hide":[{"objectID":"13"}]},"description":"Kids","validity":[{"from":1764547200,"until":1767225600},{"from":1767225600,"until":1767312000}]}`,
+		},
+		{
+			name:        "invalid anchoring",
+			ruleFlags:   RuleFlags{RuleID: "1", Pattern: "kids", Anchoring: "equals"},
+			wantsErrMsg: `invalid anchoring "equals", expected one of: is, startsWith, endsWith, contains`,
+		},
+		{
+			name:        "invalid promotion",
+			ruleFlags:   RuleFlags{RuleID: "1", Promotions: []string{"42"}},
+			wantsErrMsg: `invalid promotion "42", expected objectID:position`,
+		},
+		{
+			name:        "invalid position",
+			ruleFlags:   RuleFlags{RuleID: "1", Promotions: []string{"42:first"}},
+			wantsErrMsg: `invalid position in promotion "42:first", expected a positive integer`,
+		},
+		{
+			name:        "invalid validity",
+			ruleFlags:   RuleFlags{RuleID: "1", Validity: []string{"2026-01-01/2025-12-01"}},
+			wantsErrMsg: `invalid validity "2026-01-01/2025-12-01", the end must be after the start`,
+		},
+		{
+			name:        "invalid params",
+			ruleFlags:   RuleFlags{RuleID: "1", Params: `{"hitPerPage": 5}`},
+			wantsErrMsg: `invalid params: json: unknown field "hitPerPage"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := FlagsToRule(tt.ruleFlags)
+			if tt.wantsErrMsg != "" {
+				assert.EqualError(t, err, tt.wantsErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			b, err := rule.MarshalJSON()
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantsRule, string(b))
+		})
+	}
+}
+
+func Test_ValidateRule(t *testing.T) {
+	hide := search.Consequence{Hide: []search.ConsequenceHide{{ObjectID: "1"}}}
+	pattern := "kids"
+	anchoring := search.Anchoring("equals")
+
+	tests := []struct {
+		name        string
+		rule        search.Rule
+		wantsErrMsg string
+	}{
+		{
+			name: "valid rule",
+			rule: search.Rule{ObjectID: "1", Consequence: hide},
+		},
+		{
+			name:        "without id",
+			rule:        search.Rule{Consequence: hide},
+			wantsErrMsg: "a rule id is required",
+		},
+		{
+			name:        "without consequence",
+			rule:        search.Rule{ObjectID: "1"},
+			wantsErrMsg: "at least 1 consequence is required (promotions, hides, filters, params or user data)",
+		},
+		{
+			name: "pattern without anchoring",
+			rule: search.Rule{
+				ObjectID:    "1",
+				Conditions:  []search.Condition{{Pattern: &pattern}},
+				Consequence: hide,
+			},
+			wantsErrMsg: "an anchoring is required with a pattern",
+		},
+		{
+			name: "invalid anchoring",
+			rule: search.Rule{
+				ObjectID:    "1",
+				Conditions:  []search.Condition{{Pattern: &pattern, Anchoring: &anchoring}},
+				Consequence: hide,
+			},
+			wantsErrMsg: `invalid anchoring "equals", expected one of: is, startsWith, endsWith, contains`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRule(tt.rule)
+			if tt.wantsErrMsg != "" {
+				assert.EqualError(t, err, tt.wantsErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_FormatConditions(t *testing.T) {
+	pattern, context, filters := "star wars", "mobile", "genre:comedy"
+	anchoring := search.ANCHORING_IS
+
+	assert.Equal(t, "", FormatConditions(nil))
+	assert.Equal(
+		t,
+		`is "star wars" (mobile), filters "genre:comedy"`,
+		FormatConditions([]search.Condition{
+			{Pattern: &pattern, Anchoring: &anchoring, Context: &context},
+			{Filters: &filters},
+		}),
+	)
+}
@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// GetRawRule returns a rule as a map of its JSON attributes.
+// Unlike `GetRule` of the API client, attributes unknown to the client are kept,
+// so that the rule can be saved back without losing them.
+func GetRawRule(client *search.APIClient, index string, ruleID string) (map[string]interface{}, error) {
+	res, err := client.CustomGet(client.NewApiCustomGetRequest(rulePath(index, ruleID)))
+	if err != nil {
+		var apiErr *search.APIError
+		if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+			return nil, fmt.Errorf("rule %s doesn't exist in %s", ruleID, index)
+		}
+		return nil, fmt.Errorf("failed to get rule %s: %w", ruleID, err)
+	}
+	rule := map[string]interface{}{}
+	if res != nil {
+		for key, value := range *res {
+			// Metadata added by the API, like `_metadata` or `_highlightResult`
+			if strings.HasPrefix(key, "_") {
+				continue
+			}
+			rule[key] = value
+		}
+	}
+	return rule, nil
+}
+
+// SaveRawRule saves a rule given as a map of its JSON attributes, and returns the ID of the task.
+func SaveRawRule(
+	client *search.APIClient,
+	index string,
+	rule map[string]interface{},
+	forwardToReplicas bool,
+) (int64, error) {
+	ruleID, _ := rule["objectID"].(string)
+	res, err := client.CustomPut(
+		client.NewApiCustomPutRequest(rulePath(index, ruleID)).
+			WithParameters(map[string]any{"forwardToReplicas": forwardToReplicas}).
+			WithBody(rule),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save rule %s: %w", ruleID, err)
+	}
+
+	var taskID int64
+	if res != nil {
+		if id, ok := (*res)["taskID"].(float64); ok {
+			taskID = int64(id)
+		}
+	}
+	return taskID, nil
+}
+
+func rulePath(index string, ruleID string) string {
+	return fmt.Sprintf("1/indexes/%s/rules/%s", url.PathEscape(index), url.PathEscape(ruleID))
+}
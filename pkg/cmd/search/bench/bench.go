@@ -0,0 +1,290 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/internal/bench"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type BenchOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index        string
+	File         string
+	Queries      []string
+	SearchParams search.SearchParamsObject
+	Concurrency  int
+	Duration     time.Duration
+	Requests     int
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// BenchReport is the report of a benchmark, with its settings to compare runs.
+type BenchReport struct {
+	Index       string   `json:"index"`
+	Hosts       []string `json:"hosts,omitempty"`
+	Queries     int      `json:"queries"`
+	Concurrency int      `json:"concurrency"`
+	bench.Report
+}
+
+// NewBenchCmd creates and returns a command to benchmark the search latency of an index
+func NewBenchCmd(f *cmdutil.Factory, runF func(*BenchOptions) error) *cobra.Command {
+	opts := &BenchOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "bench <index> -F <file>",
+		Args:              validators.ExactArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "search",
+		},
+		Short: "Measure the search latency of an index.",
+		Long: heredoc.Doc(`
+			This command replays the queries of a file, one query per line, in a loop,
+			and reports the latency percentiles and the error rate of the searches.
+
+			The latency is the time of a request, seen from this machine.
+			The server time is the processingTimeMS of the responses,
+			and the network time is the latency without the server time.
+
+			The searches use the hosts of --search-hosts, if set,
+			so that you can measure the latency of a specific cluster or region.
+			Search parameters given as flags apply to all the queries.
+		`),
+		Example: heredoc.Doc(`
+			# Replay the queries of "queries.txt" on the "MOVIES" index for 60 seconds, with 8 searches at the same time
+			$ algolia search bench MOVIES -F queries.txt --concurrency 8 --duration 60s
+
+			# Replay each query once, with a filter
+			$ algolia search bench MOVIES -F queries.txt --requests $(wc -l < queries.txt) --filters "year > 2000"
+
+			# Save the report as JSON to compare it with another run
+			$ algolia search bench MOVIES -F queries.txt --search-hosts my-app-1.algolianet.com -o json > before.json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+
+			if opts.File == "" {
+				return cmdutil.FlagErrorf("a queries file (-F) is required")
+			}
+			if opts.Concurrency < 1 {
+				return cmdutil.FlagErrorf("--concurrency must be at least 1")
+			}
+			if opts.Duration <= 0 {
+				return cmdutil.FlagErrorf("--duration must be positive")
+			}
+			if opts.Requests < 0 {
+				return cmdutil.FlagErrorf("--requests can't be negative")
+			}
+
+			scanner, err := cmdutil.ScanFile(opts.File, opts.IO.In)
+			if err != nil {
+				return err
+			}
+			for scanner.Scan() {
+				if query := strings.TrimSpace(scanner.Text()); query != "" {
+					opts.Queries = append(opts.Queries, query)
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			if len(opts.Queries) == 0 {
+				return fmt.Errorf("no queries in %s", opts.File)
+			}
+
+			searchParams, err := cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
+			if err != nil {
+				return err
+			}
+			// Convert map to object
+			tmp, err := json.Marshal(searchParams)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(tmp, &opts.SearchParams); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runBenchCmd(opts)
+		},
+	}
+
+	cmd.SetUsageFunc(
+		cmdutil.UsageFuncWithFilteredAndInheritedFlags(
+			f.IOStreams,
+			cmd,
+			[]string{"file", "concurrency", "duration", "requests", "output", "template"},
+		),
+	)
+
+	cmdutil.AddSearchParamsObjectFlags(cmd)
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Replay the queries of a `file`, one per line (use \"-\" to read from standard input)")
+	cmd.Flags().
+		IntVar(&opts.Concurrency, "concurrency", 4, "Number of searches at the same time")
+	cmd.Flags().
+		DurationVar(&opts.Duration, "duration", 30*time.Second, "Stop sending searches after this `duration`")
+	cmd.Flags().
+		IntVar(&opts.Requests, "requests", 0, "Stop after this `number` of searches (default: no limit)")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runBenchCmd(opts *BenchOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Benchmarking %s", opts.Index))
+	samples, elapsed := bench.Run(
+		bench.Options{
+			Concurrency: opts.Concurrency,
+			Duration:    opts.Duration,
+			Requests:    opts.Requests,
+		},
+		opts.Queries,
+		func(query string) (time.Duration, error) {
+			params := opts.SearchParams
+			params.Query = utils.ToPtr(query)
+			res, err := client.SearchSingleIndex(
+				client.NewApiSearchSingleIndexRequest(opts.Index).
+					WithSearchParams(search.SearchParamsObjectAsSearchParams(&params)),
+			)
+			if err != nil {
+				return 0, err
+			}
+			return time.Duration(res.ProcessingTimeMS) * time.Millisecond, nil
+		},
+	)
+	opts.IO.StopProgressIndicator()
+
+	report := BenchReport{
+		Index:       opts.Index,
+		Hosts:       opts.Config.Profile().GetSearchHosts(),
+		Queries:     len(opts.Queries),
+		Concurrency: opts.Concurrency,
+		Report:      bench.Summarize(samples, elapsed),
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, report)
+	}
+
+	return printReport(opts.IO, report)
+}
+
+func printReport(io *iostreams.IOStreams, report BenchReport) error {
+	cs := io.ColorScheme()
+
+	if io.IsStdoutTTY() {
+		hosts := "default"
+		if len(report.Hosts) > 0 {
+			hosts = strings.Join(report.Hosts, ", ")
+		}
+		fmt.Fprintf(
+			io.Out,
+			"Sent %d searches to %s in %.1fs (%.1f/s), %d at the same time, with %d queries\nHosts: %s\n\n",
+			report.Requests,
+			cs.Bold(report.Index),
+			report.DurationSeconds,
+			report.RequestsPerSecond,
+			report.Concurrency,
+			report.Queries,
+			hosts,
+		)
+	}
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("TIME (MS)", nil, nil)
+		table.AddField("P50", nil, nil)
+		table.AddField("P90", nil, nil)
+		table.AddField("P99", nil, nil)
+		table.AddField("MEAN", nil, nil)
+		table.AddField("MAX", nil, nil)
+		table.EndRow()
+	}
+	for _, row := range []struct {
+		name  string
+		stats bench.Stats
+	}{
+		{"latency", report.Latency},
+		{"server", report.ProcessingTime},
+		{"network", report.Network},
+	} {
+		table.AddField(row.name, nil, nil)
+		for _, value := range []float64{row.stats.P50, row.stats.P90, row.stats.P99, row.stats.Mean, row.stats.Max} {
+			table.AddField(fmt.Sprintf("%.1f", value), nil, nil)
+		}
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if io.IsStdoutTTY() {
+		if report.Errors == 0 {
+			fmt.Fprintf(io.Out, "\n%s No errors\n", cs.SuccessIcon())
+			return nil
+		}
+		fmt.Fprintf(
+			io.Out,
+			"\n%s %d errors (%.1f%%)\n",
+			cs.FailureIcon(),
+			report.Errors,
+			report.ErrorRate*100,
+		)
+		messages := make([]string, 0, len(report.ErrorMessages))
+		for message := range report.ErrorMessages {
+			messages = append(messages, message)
+		}
+		sort.Slice(messages, func(i, j int) bool {
+			a, b := messages[i], messages[j]
+			if report.ErrorMessages[a] != report.ErrorMessages[b] {
+				return report.ErrorMessages[a] > report.ErrorMessages[b]
+			}
+			return a < b
+		})
+		for _, message := range messages {
+			fmt.Fprintf(io.Out, "  %d × %s\n", report.ErrorMessages[message], message)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package bench
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runBenchCmd(t *testing.T) {
+	t.Setenv("ALGOLIA_SEARCH_HOSTS", "my-app-1.algolianet.com")
+
+	var queries []string
+	r := httpmock.Registry{}
+	for i := 0; i < 3; i++ {
+		r.Register(
+			httpmock.REST("POST", "1/indexes/MOVIES/query"),
+			func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				queries = append(queries, strings.TrimSpace(string(body)))
+				return httpmock.StringResponse(`{"hits":[],"processingTimeMS":0}`)(req)
+			},
+		)
+	}
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(false, &r, nil, "toy story\n\nalien\n")
+	cmd := NewBenchCmd(f, nil)
+	out, err := test.Execute(cmd, "MOVIES -F - --requests 3 --concurrency 1 --hitsPerPage 5 -o json", out)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`{"hitsPerPage":5,"query":"toy story"}`,
+		`{"hitsPerPage":5,"query":"alien"}`,
+		`{"hitsPerPage":5,"query":"toy story"}`,
+	}, queries)
+
+	var report BenchReport
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &report))
+	assert.Equal(t, "MOVIES", report.Index)
+	assert.Equal(t, []string{"my-app-1.algolianet.com"}, report.Hosts)
+	assert.Equal(t, 2, report.Queries)
+	assert.Equal(t, 1, report.Concurrency)
+	assert.Equal(t, 3, report.Requests)
+	assert.Equal(t, 0, report.Errors)
+	assert.Equal(t, float64(0), report.ProcessingTime.Max)
+}
+
+func Test_NewBenchCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		wantErr string
+	}{
+		{
+			name:    "no file",
+			cli:     "MOVIES",
+			wantErr: "a queries file (-F) is required",
+		},
+		{
+			name:    "invalid concurrency",
+			cli:     "MOVIES -F - --concurrency 0",
+			wantErr: "--concurrency must be at least 1",
+		},
+		{
+			name:    "invalid duration",
+			cli:     "MOVIES -F - --duration 0s",
+			wantErr: "--duration must be positive",
+		},
+		{
+			name:    "no queries",
+			cli:     "MOVIES -F -",
+			stdin:   "\n  \n",
+			wantErr: "no queries in -",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, tt.stdin)
+			cmd := NewBenchCmd(f, func(opts *BenchOptions) error { return nil })
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
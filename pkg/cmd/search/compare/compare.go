@@ -0,0 +1,361 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/internal/compare"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type CompareOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	IndexA       string
+	IndexB       string
+	File         string
+	Queries      []string
+	SearchParams map[string]any
+	Hits         int
+	Changed      int
+	Concurrency  int
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// ComparisonReport is the comparison of the results of two indices.
+type ComparisonReport struct {
+	IndexA      string  `json:"indexA"`
+	IndexB      string  `json:"indexB"`
+	Hits        int     `json:"hits"`
+	MeanOverlap float64 `json:"meanOverlap"`
+	MeanNDCG    float64 `json:"meanNDCG"`
+	// Queries are sorted from the most to the least changed.
+	Queries []compare.Result `json:"queries"`
+}
+
+// NewCompareCmd creates and returns a command to compare the search results of two indices
+func NewCompareCmd(f *cmdutil.Factory, runF func(*CompareOptions) error) *cobra.Command {
+	opts := &CompareOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "compare <index-a> <index-b> -F <file>",
+		Args:              validators.ExactArgs(2),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "search",
+		},
+		Short: "Compare the search results of two indices.",
+		Long: heredoc.Doc(`
+			This command runs the queries of a file, one query per line, on both indices,
+			and compares the objectIDs of their first hits (see --hits):
+
+			- overlap@k: the share of the first k hits that are in both results
+			- NDCG@k: the similarity of the rankings, from 0 (nothing in common) to 1 (same ranking),
+			  with the ranking of the first index as the reference
+			- rank changes: the records that moved, appeared or disappeared
+
+			The queries are listed from the most to the least changed,
+			with the rank changes of the most changed ones (see --changed).
+			Search parameters given as flags apply to both indices.
+		`),
+		Example: heredoc.Doc(`
+			# Compare the first 10 hits of the queries of "queries.txt" on the "MOVIES" and "MOVIES_NEW_RANKING" indices
+			$ algolia search compare MOVIES MOVIES_NEW_RANKING -F queries.txt
+
+			# Compare the first 20 hits, with a filter, and show the rank changes of the 10 most changed queries
+			$ algolia search compare MOVIES MOVIES_NEW_RANKING -F queries.txt --hits 20 --changed 10 --filters "year > 2000"
+
+			# Save the comparison as JSON
+			$ algolia search compare MOVIES MOVIES_NEW_RANKING -F queries.txt -o json > comparison.json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.IndexA, opts.IndexB = args[0], args[1]
+
+			if opts.File == "" {
+				return cmdutil.FlagErrorf("a queries file (-F) is required")
+			}
+			if opts.Hits < 1 || opts.Hits > 1000 {
+				return cmdutil.FlagErrorf("--hits must be between 1 and 1000")
+			}
+			if opts.Concurrency < 1 {
+				return cmdutil.FlagErrorf("--concurrency must be at least 1")
+			}
+
+			scanner, err := cmdutil.ScanFile(opts.File, opts.IO.In)
+			if err != nil {
+				return err
+			}
+			seen := map[string]bool{}
+			for scanner.Scan() {
+				query := strings.TrimSpace(scanner.Text())
+				if query != "" && !seen[query] {
+					seen[query] = true
+					opts.Queries = append(opts.Queries, query)
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			if len(opts.Queries) == 0 {
+				return fmt.Errorf("no queries in %s", opts.File)
+			}
+
+			opts.SearchParams, err = cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
+			if err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runCompareCmd(opts)
+		},
+	}
+
+	cmd.SetUsageFunc(
+		cmdutil.UsageFuncWithFilteredAndInheritedFlags(
+			f.IOStreams,
+			cmd,
+			[]string{"file", "hits", "changed", "concurrency", "output", "template"},
+		),
+	)
+
+	cmdutil.AddSearchParamsObjectFlags(cmd)
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Compare the results of the queries of a `file`, one per line (use \"-\" to read from standard input)")
+	cmd.Flags().
+		IntVar(&opts.Hits, "hits", 10, "Number of hits to compare for each query (the k of overlap@k and NDCG@k)")
+	cmd.Flags().
+		IntVar(&opts.Changed, "changed", 5, "Show the rank changes of this `number` of most changed queries")
+	cmd.Flags().
+		IntVar(&opts.Concurrency, "concurrency", 4, "Maximum `number` of queries compared at the same time")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runCompareCmd(opts *CompareOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(
+		fmt.Sprintf("Comparing the results of %d queries", len(opts.Queries)),
+	)
+	results := make([]compare.Result, len(opts.Queries))
+	errs := make([]error, len(opts.Queries))
+	utils.ForEach(len(opts.Queries), opts.Concurrency, func(i int) {
+		results[i], errs[i] = compareQuery(client, opts, opts.Queries[i])
+	})
+	opts.IO.StopProgressIndicator()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	compare.SortByChange(results)
+	report := ComparisonReport{
+		IndexA:  opts.IndexA,
+		IndexB:  opts.IndexB,
+		Hits:    opts.Hits,
+		Queries: results,
+	}
+	report.MeanOverlap, report.MeanNDCG = compare.Mean(results)
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, report)
+	}
+
+	return printReport(opts, report)
+}
+
+// compareQuery runs the query on both indices, with a single multi-index search.
+func compareQuery(client *search.APIClient, opts *CompareOptions, query string) (compare.Result, error) {
+	var requests []search.SearchQuery
+	for _, index := range []string{opts.IndexA, opts.IndexB} {
+		params := map[string]any{}
+		for k, v := range opts.SearchParams {
+			params[k] = v
+		}
+		params["indexName"] = index
+		params["query"] = query
+		params["hitsPerPage"] = opts.Hits
+		params["attributesToRetrieve"] = []string{"objectID"}
+		params["attributesToHighlight"] = []string{}
+		params["attributesToSnippet"] = []string{}
+
+		// Convert map to object
+		tmp, err := json.Marshal(params)
+		if err != nil {
+			return compare.Result{}, err
+		}
+		var request search.SearchForHits
+		if err := json.Unmarshal(tmp, &request); err != nil {
+			return compare.Result{}, err
+		}
+		requests = append(requests, *search.SearchForHitsAsSearchQuery(&request))
+	}
+
+	res, err := client.Search(client.NewApiSearchRequest(
+		search.NewEmptySearchMethodParams().
+			SetRequests(requests).
+			SetStrategy(search.SEARCH_STRATEGY_NONE),
+	))
+	if err != nil {
+		return compare.Result{}, err
+	}
+	if len(res.Results) != 2 || res.Results[0].SearchResponse == nil || res.Results[1].SearchResponse == nil {
+		return compare.Result{}, fmt.Errorf("unexpected response for the query %q", query)
+	}
+
+	a, b := res.Results[0].SearchResponse, res.Results[1].SearchResponse
+	result := compare.Compare(query, objectIDs(a), objectIDs(b), opts.Hits)
+	result.NbHitsA = int(a.GetNbHits())
+	result.NbHitsB = int(b.GetNbHits())
+	return result, nil
+}
+
+func objectIDs(res *search.SearchResponse) []string {
+	ids := make([]string, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ObjectID)
+	}
+	return ids
+}
+
+func printReport(opts *CompareOptions, report ComparisonReport) error {
+	io := opts.IO
+	cs := io.ColorScheme()
+	k := strconv.Itoa(report.Hits)
+
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(
+			io.Out,
+			"Compared the first %d hits of %d queries on %s and %s: mean overlap@%s %.3f, mean NDCG@%s %.3f\n\n",
+			report.Hits,
+			len(report.Queries),
+			cs.Bold(report.IndexA),
+			cs.Bold(report.IndexB),
+			k,
+			report.MeanOverlap,
+			k,
+			report.MeanNDCG,
+		)
+	}
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("QUERY", nil, nil)
+		table.AddField("OVERLAP@"+k, nil, nil)
+		table.AddField("NDCG@"+k, nil, nil)
+		table.AddField("HITS A", nil, nil)
+		table.AddField("HITS B", nil, nil)
+		table.EndRow()
+	}
+	for _, result := range report.Queries {
+		table.AddField(result.Query, nil, nil)
+		table.AddField(fmt.Sprintf("%.3f", result.Overlap), nil, nil)
+		table.AddField(fmt.Sprintf("%.3f", result.NDCG), nil, nil)
+		table.AddField(strconv.Itoa(result.NbHitsA), nil, nil)
+		table.AddField(strconv.Itoa(result.NbHitsB), nil, nil)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if !io.IsStdoutTTY() {
+		return nil
+	}
+
+	var changed []compare.Result
+	for _, result := range report.Queries {
+		if result.Changed() && len(changed) < opts.Changed {
+			changed = append(changed, result)
+		}
+	}
+	if len(changed) == 0 {
+		fmt.Fprintf(io.Out, "\n%s No rank changes\n", cs.SuccessIcon())
+		return nil
+	}
+
+	fmt.Fprintf(io.Out, "\n%s\n", cs.Bold("Most changed queries"))
+	for _, result := range changed {
+		fmt.Fprintf(
+			io.Out,
+			"\n%q: overlap@%s %.3f, NDCG@%s %.3f\n",
+			result.Query,
+			k,
+			result.Overlap,
+			k,
+			result.NDCG,
+		)
+		table := printers.NewTablePrinter(io)
+		table.AddField("OBJECTID", nil, nil)
+		table.AddField("RANK A", nil, nil)
+		table.AddField("RANK B", nil, nil)
+		table.AddField("CHANGE", nil, nil)
+		table.EndRow()
+		for _, change := range result.Changes {
+			table.AddField(change.ObjectID, nil, nil)
+			table.AddField(formatRank(change.RankA), nil, nil)
+			table.AddField(formatRank(change.RankB), nil, nil)
+			table.AddField(formatChange(cs, change), nil, nil)
+			table.EndRow()
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatRank(rank int) string {
+	if rank == 0 {
+		return "-"
+	}
+	return strconv.Itoa(rank)
+}
+
+func formatChange(cs *iostreams.ColorScheme, change compare.RankChange) string {
+	switch {
+	case change.RankA == 0:
+		return cs.Green("new")
+	case change.RankB == 0:
+		return cs.Red("removed")
+	case change.RankB < change.RankA:
+		return cs.Green(fmt.Sprintf("up %d", change.RankA-change.RankB))
+	default:
+		return cs.Red(fmt.Sprintf("down %d", change.RankB-change.RankA))
+	}
+}
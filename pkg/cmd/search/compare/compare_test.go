@@ -0,0 +1,143 @@
+package compare
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+// hits returns the results of a query on both indices.
+var hits = map[string][2][]string{
+	"toy":   {{"1", "2", "3"}, {"1", "2", "3"}},
+	"alien": {{"4", "5", "6"}, {"5", "7", "4"}},
+}
+
+// multiSearch responds to a multi-index search with the hits of its query on both indices.
+func multiSearch(t *testing.T) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		var params struct {
+			Requests []struct {
+				IndexName   string `json:"indexName"`
+				Query       string `json:"query"`
+				HitsPerPage int    `json:"hitsPerPage"`
+			} `json:"requests"`
+			Strategy string `json:"strategy"`
+		}
+		require.NoError(t, json.Unmarshal(body, &params))
+		require.Len(t, params.Requests, 2)
+		assert.Equal(t, "MOVIES", params.Requests[0].IndexName)
+		assert.Equal(t, "MOVIES_NEW", params.Requests[1].IndexName)
+		assert.Equal(t, 3, params.Requests[0].HitsPerPage)
+		assert.Equal(t, "none", params.Strategy)
+
+		var results []map[string]any
+		for i, ids := range hits[params.Requests[0].Query] {
+			var records []map[string]any
+			for _, id := range ids {
+				records = append(records, map[string]any{"objectID": id})
+			}
+			results = append(results, map[string]any{
+				"hits":             records,
+				"nbHits":           10 * (i + 1),
+				"processingTimeMS": 1,
+				"query":            params.Requests[i].Query,
+				"params":           "",
+			})
+		}
+		return httpmock.JSONResponse(map[string]any{"results": results})(req)
+	}
+}
+
+func Test_runCompareCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		wantOut string
+	}{
+		{
+			name:  "tty",
+			cli:   "MOVIES MOVIES_NEW -F - --hits 3",
+			isTTY: true,
+			wantOut: "Compared the first 3 hits of 2 queries on MOVIES and MOVIES_NEW: mean overlap@3 0.834, mean NDCG@3 0.867\n\n" +
+				"QUERY  OVERLAP@3  NDCG@3  HITS A  HITS B\n" +
+				"alien  0.667      0.735   10      20\n" +
+				"toy    1.000      1.000   10      20\n" +
+				"\nMost changed queries\n" +
+				"\n\"alien\": overlap@3 0.667, NDCG@3 0.735\n" +
+				"OBJECTID  RANK A  RANK B  CHANGE\n" +
+				"5         2       1       up 1\n" +
+				"7         -       2       new\n" +
+				"4         1       3       down 2\n" +
+				"6         3       -       removed\n",
+		},
+		{
+			name:    "not tty",
+			cli:     "MOVIES MOVIES_NEW -F - --hits 3",
+			wantOut: "alien\t0.667\t0.735\t10\t20\ntoy\t1.000\t1.000\t10\t20\n",
+		},
+		{
+			name:    "json",
+			cli:     "MOVIES MOVIES_NEW -F - --hits 3 -o json",
+			wantOut: `{"indexA":"MOVIES","indexB":"MOVIES_NEW","hits":3,"meanOverlap":0.834,"meanNDCG":0.867,"queries":[{"query":"alien","nbHitsA":10,"nbHitsB":20,"overlap":0.667,"ndcg":0.735,"changes":[{"objectID":"5","rankA":2,"rankB":1},{"objectID":"7","rankA":0,"rankB":2},{"objectID":"4","rankA":1,"rankB":3},{"objectID":"6","rankA":3,"rankB":0}]},{"query":"toy","nbHitsA":10,"nbHitsB":20,"overlap":1,"ndcg":1}]}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			for range hits {
+				r.Register(httpmock.REST("POST", "1/indexes/*/queries"), multiSearch(t))
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "toy\nalien\ntoy\n")
+			cmd := NewCompareCmd(f, nil)
+			out, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_NewCompareCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no file",
+			cli:     "MOVIES MOVIES_NEW",
+			wantErr: "a queries file (-F) is required",
+		},
+		{
+			name:    "invalid hits",
+			cli:     "MOVIES MOVIES_NEW -F - --hits 0",
+			wantErr: "--hits must be between 1 and 1000",
+		},
+		{
+			name:    "one index",
+			cli:     "MOVIES -F -",
+			wantErr: "`compare` requires exactly 2 arguments.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, "toy\n")
+			cmd := NewCompareCmd(f, func(opts *CompareOptions) error { return nil })
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,699 @@
+package interactive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/algolia/cli/pkg/jsoncolor"
+)
+
+// Tags of the highlighted parts of the attributes, replaced by a style when rendering the hits
+const (
+	highlightPreTag  = "__ais-highlight__"
+	highlightPostTag = "__/ais-highlight__"
+)
+
+const (
+	// Width of the facets panel, borders included
+	facetsWidth = 34
+	// Maximum number of values shown for each facet, the refined values excluded
+	maxFacetValues = 8
+	// Maximum number of attributes shown for each hit
+	maxHitAttributes = 3
+)
+
+// Searcher runs a search on the index.
+type Searcher func(params search.SearchParamsObject) (*search.SearchResponse, error)
+
+// Run starts the full-screen search interface, until the user quits it.
+func Run(index string, params search.SearchParamsObject, searcher Searcher) error {
+	_, err := tea.NewProgram(newModel(index, params, searcher), tea.WithAltScreen()).Run()
+	return err
+}
+
+type focus int
+
+const (
+	focusQuery focus = iota
+	focusFacets
+)
+
+type keyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	NextPage key.Binding
+	PrevPage key.Binding
+	Focus    key.Binding
+	Toggle   key.Binding
+	Clear    key.Binding
+	Open     key.Binding
+	Close    key.Binding
+	Quit     key.Binding
+}
+
+var keys = keyMap{
+	Up:       key.NewBinding(key.WithKeys("up"), key.WithHelp("↑/↓", "select")),
+	Down:     key.NewBinding(key.WithKeys("down")),
+	NextPage: key.NewBinding(key.WithKeys("pgdown", "ctrl+f"), key.WithHelp("pgdn/pgup", "page")),
+	PrevPage: key.NewBinding(key.WithKeys("pgup", "ctrl+b")),
+	Focus:    key.NewBinding(key.WithKeys("tab", "shift+tab"), key.WithHelp("tab", "hits/facets")),
+	Toggle:   key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter", "toggle facet")),
+	Clear:    key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "clear facets")),
+	Open:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "show JSON")),
+	Close:    key.NewBinding(key.WithKeys("esc", "q", "enter"), key.WithHelp("esc", "back")),
+	Quit:     key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp("esc", "quit")),
+}
+
+var (
+	titleStyle     = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	statusStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	errorStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	highlightStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212")).Underline(true)
+	objectIDStyle  = lipgloss.NewStyle().Bold(true)
+	selectedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+	attributeStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	facetStyle     = lipgloss.NewStyle().Bold(true).Underline(true)
+	panelStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("241"))
+	focusedStyle   = panelStyle.BorderForeground(lipgloss.Color("170"))
+)
+
+// resultsMsg is the response of a search. seq identifies the search, to ignore the responses of outdated searches.
+type resultsMsg struct {
+	seq int
+	res *search.SearchResponse
+	err error
+}
+
+// facetValue is a value of a facet in the side panel.
+type facetValue struct {
+	attribute string
+	value     string
+	count     int32
+	refined   bool
+}
+
+type model struct {
+	index    string
+	params   search.SearchParamsObject
+	searcher Searcher
+
+	query    textinput.Model
+	viewport viewport.Model
+	help     help.Model
+
+	focus    focus
+	showJSON bool
+	// selected is the hit shown as JSON
+	selected search.Hit
+
+	seq         int
+	page        int32
+	refinements map[string]map[string]bool
+
+	res         *search.SearchResponse
+	err         error
+	hitCursor   int
+	facetCursor int
+
+	width, height int
+}
+
+func newModel(index string, params search.SearchParamsObject, searcher Searcher) *model {
+	query := textinput.New()
+	query.Prompt = "Search: "
+	query.Placeholder = "type to search"
+	query.SetValue(params.GetQuery())
+	query.Focus()
+
+	return &model{
+		index:       index,
+		params:      params,
+		searcher:    searcher,
+		query:       query,
+		viewport:    viewport.New(80, 20),
+		help:        help.New(),
+		page:        params.GetPage(),
+		refinements: map[string]map[string]bool{},
+		width:       80,
+		height:      24,
+	}
+}
+
+func (m *model) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, m.search())
+}
+
+// search runs a search with the current query, page and refinements.
+func (m *model) search() tea.Cmd {
+	m.seq++
+	seq := m.seq
+	params := m.searchParams()
+	return func() tea.Msg {
+		res, err := m.searcher(params)
+		return resultsMsg{seq: seq, res: res, err: err}
+	}
+}
+
+// searchParams returns the parameters of the search: the parameters of the command,
+// with the query, the page and the refined facets of the interface.
+func (m *model) searchParams() search.SearchParamsObject {
+	params := m.params
+	params.Query = utils.ToPtr(m.query.Value())
+	params.Page = utils.ToPtr(m.page)
+	params.HighlightPreTag = utils.ToPtr(highlightPreTag)
+	params.HighlightPostTag = utils.ToPtr(highlightPostTag)
+	if params.Facets == nil {
+		params.Facets = []string{"*"}
+	}
+
+	var filters []search.FacetFilters
+	if m.params.FacetFilters != nil {
+		if base := m.params.FacetFilters.ArrayOfFacetFilters; base != nil {
+			filters = append(filters, *base...)
+		} else {
+			filters = append(filters, *m.params.FacetFilters)
+		}
+	}
+	for _, attribute := range sortedKeys(m.refinements) {
+		var values []search.FacetFilters
+		for _, value := range sortedKeys(m.refinements[attribute]) {
+			// A leading dash would negate the filter
+			if strings.HasPrefix(value, "-") {
+				value = `\` + value
+			}
+			values = append(values, *search.StringAsFacetFilters(attribute + ":" + value))
+		}
+		if len(values) > 0 {
+			// Values of the same facet are combined with OR
+			filters = append(filters, *search.ArrayOfFacetFiltersAsFacetFilters(values))
+		}
+	}
+	if len(filters) > 0 {
+		params.FacetFilters = search.ArrayOfFacetFiltersAsFacetFilters(filters)
+	}
+
+	return params
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.help.Width = msg.Width
+		m.viewport.Width = msg.Width
+		m.viewport.Height = max(msg.Height-2, 1)
+		return m, nil
+
+	case resultsMsg:
+		if msg.seq != m.seq {
+			// Response of an outdated search
+			return m, nil
+		}
+		m.res, m.err = msg.res, msg.err
+		if m.res == nil || m.hitCursor >= len(m.res.Hits) {
+			// The selected hit isn't in the results anymore
+			m.showJSON = false
+		}
+		if m.res != nil {
+			m.hitCursor = clamp(m.hitCursor, len(m.res.Hits))
+		}
+		m.facetCursor = clamp(m.facetCursor, len(m.facetValues()))
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.showJSON {
+			switch {
+			case msg.Type == tea.KeyCtrlC:
+				return m, tea.Quit
+			case key.Matches(msg, keys.Close):
+				m.showJSON = false
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.viewport, cmd = m.viewport.Update(msg)
+			return m, cmd
+		}
+
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+
+		case key.Matches(msg, keys.Focus):
+			if m.focus == focusQuery {
+				m.focus = focusFacets
+				m.query.Blur()
+				return m, nil
+			}
+			m.focus = focusQuery
+			return m, m.query.Focus()
+
+		case key.Matches(msg, keys.Up):
+			if m.focus == focusFacets {
+				m.facetCursor = clamp(m.facetCursor-1, len(m.facetValues()))
+			} else {
+				m.hitCursor = clamp(m.hitCursor-1, m.hitsCount())
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.Down):
+			if m.focus == focusFacets {
+				m.facetCursor = clamp(m.facetCursor+1, len(m.facetValues()))
+			} else {
+				m.hitCursor = clamp(m.hitCursor+1, m.hitsCount())
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.NextPage):
+			if m.res != nil && m.page+1 < m.res.GetNbPages() {
+				m.page++
+				m.hitCursor = 0
+				return m, m.search()
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.PrevPage):
+			if m.page > 0 {
+				m.page--
+				m.hitCursor = 0
+				return m, m.search()
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.Clear):
+			if len(m.refinements) > 0 {
+				m.refinements = map[string]map[string]bool{}
+				m.page = 0
+				m.hitCursor = 0
+				return m, m.search()
+			}
+			return m, nil
+
+		case m.focus == focusFacets && key.Matches(msg, keys.Toggle):
+			values := m.facetValues()
+			if m.facetCursor < len(values) {
+				m.toggle(values[m.facetCursor])
+				m.page = 0
+				m.hitCursor = 0
+				return m, m.search()
+			}
+			return m, nil
+
+		case m.focus == focusQuery && key.Matches(msg, keys.Open):
+			if m.hitCursor < m.hitsCount() {
+				m.selected = m.res.Hits[m.hitCursor]
+				m.viewport.SetContent(hitJSON(m.selected))
+				m.viewport.GotoTop()
+				m.showJSON = true
+			}
+			return m, nil
+		}
+
+		if m.focus == focusQuery {
+			previous := m.query.Value()
+			var cmd tea.Cmd
+			m.query, cmd = m.query.Update(msg)
+			if m.query.Value() != previous {
+				m.page = 0
+				m.hitCursor = 0
+				return m, tea.Batch(cmd, m.search())
+			}
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.query, cmd = m.query.Update(msg)
+	return m, cmd
+}
+
+func (m *model) toggle(v facetValue) {
+	if v.refined {
+		delete(m.refinements[v.attribute], v.value)
+		if len(m.refinements[v.attribute]) == 0 {
+			delete(m.refinements, v.attribute)
+		}
+		return
+	}
+	if m.refinements[v.attribute] == nil {
+		m.refinements[v.attribute] = map[string]bool{}
+	}
+	m.refinements[v.attribute][v.value] = true
+}
+
+func (m *model) hitsCount() int {
+	if m.res == nil {
+		return 0
+	}
+	return len(m.res.Hits)
+}
+
+// facetValues returns the values of the facets panel: for each facet, the refined values
+// and the values with the most results.
+func (m *model) facetValues() []facetValue {
+	facets := map[string]map[string]int32{}
+	if m.res != nil && m.res.Facets != nil {
+		facets = *m.res.Facets
+	}
+
+	attributes := map[string]bool{}
+	for attribute := range facets {
+		attributes[attribute] = true
+	}
+	for attribute := range m.refinements {
+		attributes[attribute] = true
+	}
+
+	var values []facetValue
+	for _, attribute := range sortedKeys(attributes) {
+		var attributeValues []facetValue
+		for value, count := range facets[attribute] {
+			attributeValues = append(attributeValues, facetValue{
+				attribute: attribute,
+				value:     value,
+				count:     count,
+				refined:   m.refinements[attribute][value],
+			})
+		}
+		for value := range m.refinements[attribute] {
+			if _, ok := facets[attribute][value]; !ok {
+				attributeValues = append(attributeValues, facetValue{attribute: attribute, value: value, refined: true})
+			}
+		}
+		sort.Slice(attributeValues, func(i, j int) bool {
+			a, b := attributeValues[i], attributeValues[j]
+			if a.count != b.count {
+				return a.count > b.count
+			}
+			return a.value < b.value
+		})
+
+		shown := 0
+		for _, v := range attributeValues {
+			if v.refined || shown < maxFacetValues {
+				values = append(values, v)
+				if !v.refined {
+					shown++
+				}
+			}
+		}
+	}
+	return values
+}
+
+func (m *model) View() string {
+	if m.showJSON {
+		header := titleStyle.Render(m.selected.ObjectID) + statusStyle.Render("  ↑/↓ scroll • esc back")
+		return header + "\n" + m.viewport.View()
+	}
+
+	header := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titleStyle.Render(m.index)+"  ",
+		m.query.View(),
+	)
+	status := m.status()
+	if gap := m.width - lipgloss.Width(header) - lipgloss.Width(status); gap > 0 {
+		header += strings.Repeat(" ", gap)
+	} else {
+		header += "  "
+	}
+	header += status
+
+	// Header, footer, and the borders of the panels
+	bodyHeight := max(m.height-5, 1)
+
+	facetsPanel := panelStyle
+	hitsPanel := focusedStyle
+	if m.focus == focusFacets {
+		facetsPanel, hitsPanel = focusedStyle, panelStyle
+	}
+	hitsWidth := max(m.width-facetsWidth-2, 10)
+	body := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		facetsPanel.Width(facetsWidth-2).Height(bodyHeight).Render(m.facetsView(facetsWidth-2, bodyHeight)),
+		hitsPanel.Width(hitsWidth).Height(bodyHeight).Render(m.hitsView(hitsWidth, bodyHeight)),
+	)
+
+	bindings := []key.Binding{keys.Up, keys.NextPage, keys.Focus, keys.Open, keys.Quit}
+	if m.focus == focusFacets {
+		bindings = []key.Binding{keys.Up, keys.Toggle, keys.Clear, keys.Focus, keys.Quit}
+	}
+	footer := m.pageView() + "  " + m.help.ShortHelpView(bindings)
+
+	return header + "\n" + body + "\n" + footer
+}
+
+func (m *model) status() string {
+	switch {
+	case m.err != nil:
+		return errorStyle.Render("Error")
+	case m.res == nil:
+		return statusStyle.Render("Searching...")
+	}
+	hits := fmt.Sprintf("%d hits", m.res.GetNbHits())
+	if m.res.GetNbHits() == 1 {
+		hits = "1 hit"
+	}
+	return statusStyle.Render(fmt.Sprintf("%s in %dms", hits, m.res.ProcessingTimeMS))
+}
+
+func (m *model) pageView() string {
+	if m.res == nil || m.res.GetNbPages() == 0 {
+		return statusStyle.Render("Page 0 of 0")
+	}
+	return statusStyle.Render(fmt.Sprintf("Page %d of %d", m.page+1, m.res.GetNbPages()))
+}
+
+func (m *model) hitsView(width int, height int) string {
+	switch {
+	case m.err != nil:
+		return errorStyle.Width(width).Render(m.err.Error())
+	case m.res == nil:
+		return statusStyle.Render("Searching...")
+	case len(m.res.Hits) == 0:
+		return statusStyle.Render("No results")
+	}
+
+	hitsPerPage := int32(len(m.res.Hits))
+	if m.res.HitsPerPage != nil {
+		hitsPerPage = *m.res.HitsPerPage
+	}
+
+	truncate := lipgloss.NewStyle().MaxWidth(width).Render
+	var lines []string
+	selectedStart, selectedEnd := 0, 0
+	for i, hit := range m.res.Hits {
+		if i == m.hitCursor {
+			selectedStart = len(lines)
+		}
+		cursor := "  "
+		if i == m.hitCursor {
+			cursor = selectedStyle.Render("> ")
+		}
+		position := int(m.page*hitsPerPage) + i + 1
+		lines = append(lines, truncate(fmt.Sprintf("%s%d. %s", cursor, position, objectIDStyle.Render(hit.ObjectID))))
+		for _, a := range hitAttributes(hit) {
+			lines = append(lines, truncate(fmt.Sprintf("     %s %s", attributeStyle.Render(a.name+":"), a.value)))
+		}
+		if i == m.hitCursor {
+			selectedEnd = len(lines)
+		}
+	}
+
+	return strings.Join(window(lines, selectedStart, selectedEnd, height), "\n")
+}
+
+func (m *model) facetsView(width int, height int) string {
+	values := m.facetValues()
+	if len(values) == 0 {
+		return statusStyle.Render("No facets")
+	}
+
+	truncate := lipgloss.NewStyle().MaxWidth(width).Render
+	var lines []string
+	selectedStart, selectedEnd := 0, 0
+	attribute := ""
+	for i, v := range values {
+		if v.attribute != attribute {
+			if attribute != "" {
+				lines = append(lines, "")
+			}
+			attribute = v.attribute
+			lines = append(lines, truncate(facetStyle.Render(attribute)))
+		}
+
+		cursor := "  "
+		if m.focus == focusFacets && i == m.facetCursor {
+			cursor = selectedStyle.Render("> ")
+		}
+		check := "[ ]"
+		if v.refined {
+			check = selectedStyle.Render("[x]")
+		}
+		count := statusStyle.Render(fmt.Sprintf("%d", v.count))
+		label := lipgloss.NewStyle().
+			MaxWidth(max(width-lipgloss.Width(cursor+check+count)-2, 1)).
+			Render(v.value)
+
+		if i == m.facetCursor {
+			selectedStart = len(lines)
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s %s", cursor, check, label, count))
+		if i == m.facetCursor {
+			selectedEnd = len(lines)
+		}
+	}
+
+	return strings.Join(window(lines, selectedStart, selectedEnd, height), "\n")
+}
+
+// hitAttribute is an attribute of a hit, with its highlighted value.
+type hitAttribute struct {
+	name    string
+	value   string
+	matched bool
+}
+
+// hitAttributes returns the attributes shown for a hit: the attributes that match the query first.
+func hitAttributes(hit search.Hit) []hitAttribute {
+	var attributes []hitAttribute
+	if hit.HighlightResult != nil {
+		for name, result := range *hit.HighlightResult {
+			value, matched := flattenHighlight(result)
+			attributes = append(attributes, hitAttribute{name: name, value: renderHighlight(value), matched: matched})
+		}
+	} else {
+		for name, v := range hit.AdditionalProperties {
+			if s, ok := v.(string); ok {
+				attributes = append(attributes, hitAttribute{name: name, value: s})
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			attributes = append(attributes, hitAttribute{name: name, value: string(b)})
+		}
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		if attributes[i].matched != attributes[j].matched {
+			return attributes[i].matched
+		}
+		return attributes[i].name < attributes[j].name
+	})
+	if len(attributes) > maxHitAttributes {
+		attributes = attributes[:maxHitAttributes]
+	}
+	return attributes
+}
+
+// flattenHighlight returns the highlighted value of an attribute on a single line,
+// and whether it matches the query.
+func flattenHighlight(result search.HighlightResult) (string, bool) {
+	switch {
+	case result.HighlightResultOption != nil:
+		value := strings.Join(strings.Fields(result.HighlightResultOption.Value), " ")
+		return value, result.HighlightResultOption.MatchLevel != search.MATCH_LEVEL_NONE
+	case result.ArrayOfHighlightResult != nil:
+		var values []string
+		matched := false
+		for _, r := range *result.ArrayOfHighlightResult {
+			v, m := flattenHighlight(r)
+			values = append(values, v)
+			matched = matched || m
+		}
+		return strings.Join(values, ", "), matched
+	case result.MapmapOfStringHighlightResult != nil:
+		results := *result.MapmapOfStringHighlightResult
+		var values []string
+		matched := false
+		for _, k := range sortedKeys(results) {
+			v, m := flattenHighlight(results[k])
+			values = append(values, k+": "+v)
+			matched = matched || m
+		}
+		return "{" + strings.Join(values, ", ") + "}", matched
+	}
+	return "", false
+}
+
+// renderHighlight replaces the highlight tags of a value with the highlight style.
+func renderHighlight(value string) string {
+	b := strings.Builder{}
+	for {
+		start := strings.Index(value, highlightPreTag)
+		if start < 0 {
+			break
+		}
+		b.WriteString(value[:start])
+		value = value[start+len(highlightPreTag):]
+		end := strings.Index(value, highlightPostTag)
+		if end < 0 {
+			end = len(value)
+		}
+		b.WriteString(highlightStyle.Render(value[:end]))
+		value = strings.TrimPrefix(value[end:], highlightPostTag)
+	}
+	b.WriteString(value)
+	return b.String()
+}
+
+// hitJSON returns the colored JSON of a hit, without the highlights and the snippets added by the search.
+func hitJSON(hit search.Hit) string {
+	hit.HighlightResult = nil
+	hit.SnippetResult = nil
+	b, err := json.Marshal(hit)
+	if err != nil {
+		return err.Error()
+	}
+	buf := bytes.Buffer{}
+	if err := jsoncolor.Write(&buf, bytes.NewReader(b), "  "); err != nil {
+		return string(b)
+	}
+	return buf.String()
+}
+
+// window returns the lines that fit in the height, with the selected lines (from start to end) visible.
+func window(lines []string, start int, end int, height int) []string {
+	if len(lines) <= height {
+		return lines
+	}
+	offset := 0
+	if end > height {
+		offset = min(end-height, start)
+	}
+	return lines[offset:min(offset+height, len(lines))]
+}
+
+func clamp(i int, length int) int {
+	if i >= length {
+		i = length - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,218 @@
+package interactive
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const response = `{
+	"hits":[
+		{"objectID":"1","title":"Toy Story","genre":"Animation","_highlightResult":{
+			"title":{"value":"__ais-highlight__Toy__/ais-highlight__ Story","matchLevel":"full","matchedWords":["toy"]},
+			"genre":{"value":"Animation","matchLevel":"none","matchedWords":[]}
+		}},
+		{"objectID":"2","title":"Toy Soldiers","genre":"Action"}
+	],
+	"facets":{"genre":{"Animation":12,"Action":3,"-Other":1}},
+	"nbHits":15,"page":0,"nbPages":8,"hitsPerPage":2,"processingTimeMS":3,"query":"toy","params":""
+}`
+
+// fakeSearcher records the parameters of the searches and returns the same response.
+type fakeSearcher struct {
+	params []search.SearchParamsObject
+}
+
+func (f *fakeSearcher) search(params search.SearchParamsObject) (*search.SearchResponse, error) {
+	f.params = append(f.params, params)
+	var res search.SearchResponse
+	err := json.Unmarshal([]byte(response), &res)
+	return &res, err
+}
+
+// run runs a command and its batched commands, and sends the messages they return to the model.
+func run(m *model, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			run(m, c)
+		}
+	case resultsMsg:
+		m.Update(msg)
+	}
+}
+
+func press(m *model, keys ...tea.KeyMsg) {
+	for _, k := range keys {
+		_, cmd := m.Update(k)
+		run(m, cmd)
+	}
+}
+
+func newTestModel(t *testing.T, params search.SearchParamsObject) (*model, *fakeSearcher) {
+	t.Helper()
+	searcher := &fakeSearcher{}
+	m := newModel("MOVIES", params, searcher.search)
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	run(m, m.search())
+	require.NotNil(t, m.res)
+	return m, searcher
+}
+
+func facetFilters(t *testing.T, params search.SearchParamsObject) string {
+	t.Helper()
+	if params.FacetFilters == nil {
+		return ""
+	}
+	b, err := json.Marshal(params.FacetFilters)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func Test_searchAsYouType(t *testing.T) {
+	m, searcher := newTestModel(t, search.SearchParamsObject{Query: utils.ToPtr("to")})
+
+	press(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	last := searcher.params[len(searcher.params)-1]
+	assert.Equal(t, "toy", last.GetQuery())
+	assert.Equal(t, []string{"*"}, last.Facets)
+	assert.Equal(t, highlightPreTag, last.GetHighlightPreTag())
+	assert.Equal(t, int32(0), last.GetPage())
+
+	view := m.View()
+	assert.Contains(t, view, "MOVIES")
+	assert.Contains(t, view, "15 hits in 3ms")
+	assert.Contains(t, view, "1. 1")
+	assert.Contains(t, view, "title: Toy Story")
+	assert.Contains(t, view, "Page 1 of 8")
+	assert.NotContains(t, view, highlightPreTag)
+}
+
+func Test_pagination(t *testing.T) {
+	m, searcher := newTestModel(t, search.SearchParamsObject{})
+
+	press(m, tea.KeyMsg{Type: tea.KeyPgDown}, tea.KeyMsg{Type: tea.KeyPgDown}, tea.KeyMsg{Type: tea.KeyPgUp})
+
+	require.Len(t, searcher.params, 4)
+	assert.Equal(t, int32(1), searcher.params[1].GetPage())
+	assert.Equal(t, int32(2), searcher.params[2].GetPage())
+	assert.Equal(t, int32(1), searcher.params[3].GetPage())
+	assert.Contains(t, m.View(), "3. 1")
+}
+
+func Test_toggleFacets(t *testing.T) {
+	m, searcher := newTestModel(t, search.SearchParamsObject{
+		FacetFilters: search.StringAsFacetFilters("year:2000"),
+	})
+
+	// The facets are sorted by count: Animation, Action, -Other
+	press(m,
+		tea.KeyMsg{Type: tea.KeyTab},
+		tea.KeyMsg{Type: tea.KeyEnter},
+		tea.KeyMsg{Type: tea.KeyDown},
+		tea.KeyMsg{Type: tea.KeyDown},
+		tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")},
+	)
+	require.Len(t, searcher.params, 3)
+	assert.Equal(t, `["year:2000",["genre:Animation"]]`, facetFilters(t, searcher.params[1]))
+	assert.Equal(t, `["year:2000",["genre:\\-Other","genre:Animation"]]`, facetFilters(t, searcher.params[2]))
+	assert.Contains(t, m.View(), "[x] Animation 12")
+
+	// Untoggle the selected value
+	press(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, `["year:2000",["genre:Animation"]]`, facetFilters(t, searcher.params[3]))
+
+	// Clear all the refinements
+	press(m, tea.KeyMsg{Type: tea.KeyCtrlX})
+	assert.Equal(t, `["year:2000"]`, facetFilters(t, searcher.params[4]))
+}
+
+func Test_outdatedResults(t *testing.T) {
+	m, _ := newTestModel(t, search.SearchParamsObject{})
+
+	outdated := m.search()
+	current := m.search()
+	m.Update(resultsMsg{seq: m.seq, res: &search.SearchResponse{Hits: []search.Hit{}}})
+	run(m, outdated)
+	assert.Empty(t, m.res.Hits)
+	assert.Contains(t, m.View(), "No results")
+
+	run(m, current)
+	assert.Len(t, m.res.Hits, 2)
+}
+
+func Test_showJSON(t *testing.T) {
+	m, _ := newTestModel(t, search.SearchParamsObject{})
+
+	press(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyEnter})
+	require.True(t, m.showJSON)
+	view := m.View()
+	assert.Contains(t, view, `"Toy Soldiers"`)
+	assert.NotContains(t, view, "_highlightResult")
+
+	press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.showJSON)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+func Test_showJSON_failedSearch(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  resultsMsg
+	}{
+		{
+			name: "error",
+			msg:  resultsMsg{err: errors.New("network error")},
+		},
+		{
+			name: "no hits",
+			msg:  resultsMsg{res: &search.SearchResponse{Hits: []search.Hit{}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newTestModel(t, search.SearchParamsObject{})
+
+			// A search is still running when the JSON view is opened
+			m.search()
+			press(m, tea.KeyMsg{Type: tea.KeyEnter})
+			require.True(t, m.showJSON)
+
+			tt.msg.seq = m.seq
+			m.Update(tt.msg)
+			assert.False(t, m.showJSON)
+			assert.NotPanics(t, func() { m.View() })
+		})
+	}
+}
+
+func Test_renderHighlight(t *testing.T) {
+	assert.Equal(t, "Toy Story", renderHighlight("__ais-highlight__Toy__/ais-highlight__ Story"))
+	assert.Equal(t, "no highlight", renderHighlight("no highlight"))
+}
+
+func Test_flattenHighlight(t *testing.T) {
+	var result search.HighlightResult
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"value":"Tom","matchLevel":"none","matchedWords":[]},
+		{"value":"__ais-highlight__Tim__/ais-highlight__","matchLevel":"full","matchedWords":["tim"]}
+	]`), &result))
+
+	value, matched := flattenHighlight(result)
+	assert.Equal(t, "Tom, __ais-highlight__Tim__/ais-highlight__", value)
+	assert.True(t, matched)
+}
@@ -1,12 +1,18 @@
 package search
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/MakeNowJust/heredoc"
 	algoliaSearch "github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	"github.com/algolia/cli/pkg/cmd/search/bench"
+	"github.com/algolia/cli/pkg/cmd/search/compare"
+	"github.com/algolia/cli/pkg/cmd/search/interactive"
 	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
@@ -22,7 +28,14 @@ type SearchOptions struct {
 
 	Index        string
 	SearchParams *algoliaSearch.SearchParamsObject
-	PrintFlags   *cmdutil.PrintFlags
+	Interactive  bool
+
+	Multi    bool
+	File     string
+	Strategy algoliaSearch.SearchStrategy
+	Scanner  *bufio.Scanner
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewSearchCmd returns a new instance of the search command
@@ -34,12 +47,22 @@ func NewSearchCmd(f *cmdutil.Factory) *cobra.Command {
 		PrintFlags:   cmdutil.NewPrintFlags().WithDefaultOutput("json"),
 	}
 
+	var strategy string
+
 	cmd := &cobra.Command{
-		Use:               "search <index>",
+		Use:               "search {<index> | --multi -F <file>}",
 		Short:             "Search an index",
-		Args:              validators.ExactArgs(1),
+		Args:              cobra.MaximumNArgs(1),
 		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
-		Long:              `Search for records in your index.`,
+		Long: heredoc.Doc(`
+			Search for records in your index.
+
+			With --multi, send the search requests of a file in a single multi-index search,
+			like a federated search interface does.
+			The file has one JSON request per line, with the index name and the search parameters,
+			like {"indexName": "MOVIES", "query": "toy story", "hitsPerPage": 5}.
+			The command prints one result per request, in the order of the requests.
+		`),
 		Annotations: map[string]string{
 			"runInWebCLI": "true",
 			"acls":        "search",
@@ -59,9 +82,46 @@ func NewSearchCmd(f *cmdutil.Factory) *cobra.Command {
 
 			# Search for records in the "MOVIES" index matching the query "toy story" and only export the results to a .json file
 			$ algolia search MOVIES --query "toy story" --output="jsonpath={$.Hits}" > movies.json
+
+			# Search the "MOVIES" index as you type, with the facets in a side panel
+			$ algolia search MOVIES --interactive
+
+			# Search the "MOVIES" index as you type, with the "genres" and "year" facets only
+			$ algolia search MOVIES --interactive --facets genres,year
+
+			# Send the search requests of the "requests.ndjson" file in a single multi-index search
+			$ algolia search --multi -F requests.ndjson
+
+			# Send the search requests one by one, until one of them has enough hits
+			$ algolia search --multi -F requests.ndjson --strategy stopIfEnoughMatches
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Multi {
+				if err := parseMultiFlags(cmd, opts, args, strategy); err != nil {
+					return err
+				}
+				return runMultiCmd(opts)
+			}
+			if opts.File != "" {
+				return cmdutil.FlagErrorf("--file requires --multi")
+			}
+			if cmd.Flags().Changed("strategy") {
+				return cmdutil.FlagErrorf("--strategy requires --multi")
+			}
+			if err := validators.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
 			opts.Index = args[0]
+
+			if opts.Interactive {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("--interactive requires an interactive terminal")
+				}
+				if opts.PrintFlags.OutputFlagSpecified() {
+					return cmdutil.FlagErrorf("--interactive can't be used with --output")
+				}
+			}
+
 			searchParams, err := cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
 			if err != nil {
 				return err
@@ -77,6 +137,10 @@ func NewSearchCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			if opts.Interactive {
+				return runInteractiveCmd(opts)
+			}
+
 			return runSearchCmd(opts)
 		},
 	}
@@ -87,8 +151,24 @@ func NewSearchCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddSearchParamsObjectFlags(cmd)
 
+	cmd.Flags().
+		BoolVar(&opts.Interactive, "interactive", false, "Search as you type in a full-screen interface, with the facets and the highlighted hits")
+	cmd.Flags().
+		BoolVar(&opts.Multi, "multi", false, "Send the search requests of a file (-F) in a single multi-index search")
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Read the search requests from a `file`, one JSON request per line (use \"-\" to read from standard input)")
+	cmd.Flags().
+		StringVar(&strategy, "strategy", string(algoliaSearch.SEARCH_STRATEGY_NONE), "Strategy of the multi-index search: none or stopIfEnoughMatches")
+	_ = cmd.RegisterFlagCompletionFunc("strategy", cmdutil.StringCompletionFunc(map[string]string{
+		string(algoliaSearch.SEARCH_STRATEGY_NONE):                   "Run all the requests",
+		string(algoliaSearch.SEARCH_STRATEGY_STOP_IF_ENOUGH_MATCHES): "Run the requests one by one, until one has at least hitsPerPage hits",
+	}))
+
 	opts.PrintFlags.AddFlags(cmd)
 
+	cmd.AddCommand(bench.NewBenchCmd(f, nil))
+	cmd.AddCommand(compare.NewCompareCmd(f, nil))
+
 	return cmd
 }
 
@@ -118,3 +198,133 @@ func runSearchCmd(opts *SearchOptions) error {
 
 	return p.Print(opts.IO, res)
 }
+
+func runInteractiveCmd(opts *SearchOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	return interactive.Run(
+		opts.Index,
+		*opts.SearchParams,
+		func(params algoliaSearch.SearchParamsObject) (*algoliaSearch.SearchResponse, error) {
+			return client.SearchSingleIndex(
+				client.NewApiSearchSingleIndexRequest(opts.Index).
+					WithSearchParams(algoliaSearch.SearchParamsObjectAsSearchParams(&params)),
+			)
+		},
+	)
+}
+
+// parseMultiFlags checks the flags of a multi-index search.
+func parseMultiFlags(cmd *cobra.Command, opts *SearchOptions, args []string, strategy string) error {
+	if len(args) > 0 {
+		return cmdutil.FlagErrorf("--multi doesn't take an index: set the index of each request in the file")
+	}
+	if opts.File == "" {
+		return cmdutil.FlagErrorf("--multi requires a file of search requests (-F)")
+	}
+	if opts.Interactive {
+		return cmdutil.FlagErrorf("--interactive can't be used with --multi")
+	}
+	searchParams, err := cmdutil.FlagValuesMap(cmd.Flags(), cmdutil.SearchParamsObject...)
+	if err != nil {
+		return err
+	}
+	if len(searchParams) > 0 {
+		return cmdutil.FlagErrorf("search parameters can't be used with --multi: set them in the requests of the file")
+	}
+
+	s, err := algoliaSearch.NewSearchStrategyFromValue(strategy)
+	if err != nil {
+		return cmdutil.FlagErrorf("invalid --strategy %q, expected one of: none, stopIfEnoughMatches", strategy)
+	}
+	opts.Strategy = *s
+
+	opts.Scanner, err = cmdutil.ScanFile(opts.File, opts.IO.In)
+	return err
+}
+
+func runMultiCmd(opts *SearchOptions) error {
+	var requests []algoliaSearch.SearchQuery
+	line := 0
+	for opts.Scanner.Scan() {
+		line++
+		text := opts.Scanner.Text()
+		if text == "" {
+			continue
+		}
+		request, err := parseSearchRequest([]byte(text))
+		if err != nil {
+			return fmt.Errorf("failed to parse search request on line %d: %s", line, err)
+		}
+		requests = append(requests, request)
+	}
+	if err := opts.Scanner.Err(); err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return fmt.Errorf("no search requests in %s", opts.File)
+	}
+
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	p, err := opts.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Searching")
+	res, err := client.Search(client.NewApiSearchRequest(
+		algoliaSearch.NewEmptySearchMethodParams().
+			SetRequests(requests).
+			SetStrategy(opts.Strategy),
+	))
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	for _, result := range res.Results {
+		// Print the search response itself, so that the JSONPath templates are the same as for a single search
+		if err := p.Print(opts.IO, result.GetActualInstance()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSearchRequest parses a request of a multi-index search:
+// a search for hits, or a search for facet values with "type": "facet".
+func parseSearchRequest(data []byte) (algoliaSearch.SearchQuery, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return algoliaSearch.SearchQuery{}, err
+	}
+	if _, ok := fields["indexName"]; !ok {
+		return algoliaSearch.SearchQuery{}, fmt.Errorf("missing indexName")
+	}
+
+	var request algoliaSearch.SearchQuery
+	if err := json.Unmarshal(data, &request); err == nil {
+		return request, nil
+	}
+
+	// The client doesn't tell why the request is invalid: decode it again to find out
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var err error
+	if _, ok := fields["facet"]; ok {
+		err = decoder.Decode(&algoliaSearch.SearchForFacets{})
+	} else {
+		err = decoder.Decode(&algoliaSearch.SearchForHits{})
+	}
+	if err != nil {
+		return algoliaSearch.SearchQuery{}, err
+	}
+	return algoliaSearch.SearchQuery{}, fmt.Errorf("invalid search request")
+}
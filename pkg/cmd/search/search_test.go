@@ -0,0 +1,134 @@
+package search
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const requests = `{"indexName":"MOVIES","query":"toy","hitsPerPage":1}
+
+{"indexName":"ACTORS","query":"tom","attributesToRetrieve":["name"]}
+`
+
+func Test_runMultiCmd(t *testing.T) {
+	tests := []struct {
+		name         string
+		cli          string
+		wantStrategy string
+	}{
+		{
+			name:         "default strategy",
+			cli:          "--multi -F -",
+			wantStrategy: "none",
+		},
+		{
+			name:         "stop if enough matches",
+			cli:          "--multi -F - --strategy stopIfEnoughMatches",
+			wantStrategy: "stopIfEnoughMatches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("POST", "1/indexes/*/queries"),
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.Equal(
+						t,
+						`{"requests":[{"hitsPerPage":1,"indexName":"MOVIES","query":"toy"},{"attributesToRetrieve":["name"],"indexName":"ACTORS","query":"tom"}],"strategy":"`+tt.wantStrategy+`"}`,
+						strings.TrimSpace(string(body)),
+					)
+					return httpmock.StringResponse(`{"results":[
+						{"hits":[{"objectID":"1"}],"nbHits":3,"processingTimeMS":1,"query":"toy","params":"","index":"MOVIES"},
+						{"hits":[],"nbHits":0,"processingTimeMS":1,"query":"tom","params":"","index":"ACTORS"}
+					]}`)(req)
+				},
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(false, &r, nil, requests)
+			cmd := NewSearchCmd(f)
+			out, err := test.Execute(cmd, tt.cli+" -o jsonpath={$.Index}", out)
+			require.NoError(t, err)
+			assert.Equal(t, "MOVIES\nACTORS\n", out.String())
+		})
+	}
+}
+
+func Test_NewSearchCmd_multi(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		wantErr string
+	}{
+		{
+			name:    "index with --multi",
+			cli:     "MOVIES --multi -F -",
+			wantErr: "--multi doesn't take an index: set the index of each request in the file",
+		},
+		{
+			name:    "no file",
+			cli:     "--multi",
+			wantErr: "--multi requires a file of search requests (-F)",
+		},
+		{
+			name:    "search parameters with --multi",
+			cli:     "--multi -F - --hitsPerPage 2",
+			wantErr: "search parameters can't be used with --multi: set them in the requests of the file",
+		},
+		{
+			name:    "invalid strategy",
+			cli:     "--multi -F - --strategy all",
+			wantErr: `invalid --strategy "all", expected one of: none, stopIfEnoughMatches`,
+		},
+		{
+			name:    "file without --multi",
+			cli:     "MOVIES -F -",
+			wantErr: "--file requires --multi",
+		},
+		{
+			name:    "no index",
+			cli:     "",
+			wantErr: "`search` requires exactly 1 argument.",
+		},
+		{
+			name:    "missing index name",
+			cli:     "--multi -F -",
+			stdin:   `{"query":"toy"}`,
+			wantErr: "failed to parse search request on line 1: missing indexName",
+		},
+		{
+			name:    "unknown parameter",
+			cli:     "--multi -F -",
+			stdin:   `{"indexName":"MOVIES","hitPerPage":1}`,
+			wantErr: `failed to parse search request on line 1: json: unknown field "hitPerPage"`,
+		},
+		{
+			name:    "empty file",
+			cli:     "--multi -F -",
+			stdin:   "\n",
+			wantErr: "no search requests in -",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(false, nil, nil, tt.stdin)
+			cmd := NewSearchCmd(f)
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
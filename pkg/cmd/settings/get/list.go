@@ -1,25 +1,32 @@
 package get
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	"github.com/algolia/cli/pkg/cmd/shared/config"
 	"github.com/algolia/cli/pkg/cmdutil"
-	"github.com/algolia/cli/pkg/config"
+	cliconfig "github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
 	"github.com/algolia/cli/pkg/validators"
 )
 
 type GetOptions struct {
-	Config config.IConfig
+	Config cliconfig.IConfig
 	IO     *iostreams.IOStreams
 
 	SearchClient func() (*search.APIClient, error)
 
-	Index string
+	Index      string
+	NonDefault bool
+	Explain    bool
 
 	PrintFlags *cmdutil.PrintFlags
 }
@@ -40,18 +47,42 @@ func NewGetCmd(f *cmdutil.Factory) *cobra.Command {
 			"runInWebCLI": "true",
 			"acls":        "settings",
 		},
+		Long: heredoc.Doc(`
+			Get the settings of the specified index.
+
+			With --non-default, only the settings which differ from their default value are shown.
+			With --explain, the settings are shown in a table, next to their description.
+		`),
 		Example: heredoc.Doc(`
 			# Store the settings of an index in a file
 			$ algolia settings get MOVIES > movies_settings.json
+
+			# Only show the settings of an index which differ from their default value
+			$ algolia settings get MOVIES --non-default
+
+			# Show the non-default settings of an index with their description
+			$ algolia settings get MOVIES --non-default --explain
 		`),
 		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
 
+			if err := cmdutil.MutuallyExclusive(
+				"--explain can't be used with --output",
+				opts.Explain,
+				opts.PrintFlags.OutputFlagSpecified(),
+			); err != nil {
+				return err
+			}
+
 			return runListCmd(opts)
 		},
 	}
 
+	cmd.Flags().
+		BoolVar(&opts.NonDefault, "non-default", false, "Only show the settings which differ from their default value")
+	cmd.Flags().BoolVar(&opts.Explain, "explain", false, "Show the description of each setting next to its value")
+
 	opts.PrintFlags.AddFlags(cmd)
 
 	return cmd
@@ -75,5 +106,78 @@ func runListCmd(opts *GetOptions) error {
 		return err
 	}
 
-	return p.Print(opts.IO, res)
+	if !opts.NonDefault && !opts.Explain {
+		return p.Print(opts.IO, res)
+	}
+
+	settings, err := config.ToMap(res)
+	if err != nil {
+		return err
+	}
+	if opts.NonDefault {
+		for key, value := range settings {
+			if isDefault(key, value) {
+				delete(settings, key)
+			}
+		}
+	}
+
+	if opts.Explain {
+		return printExplain(opts.IO, settings)
+	}
+	return p.Print(opts.IO, settings)
+}
+
+// isDefault returns true if the value of a setting is its default value from the API spec.
+// Settings without a default value are considered default when they're empty.
+func isDefault(key string, value interface{}) bool {
+	spec, ok := cmdutil.IndexSettingsSpecs[key]
+	if !ok {
+		return false
+	}
+	if def, ok := spec.DefaultValue(); ok {
+		return reflect.DeepEqual(def, value)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// printExplain prints the settings in a table, with the description of each setting.
+func printExplain(io *iostreams.IOStreams, settings map[string]interface{}) error {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := printers.NewTablePrinter(io)
+	if table.IsTTY() {
+		table.AddField("SETTING", nil, nil)
+		table.AddField("VALUE", nil, nil)
+		table.AddField("DESCRIPTION", nil, nil)
+		table.EndRow()
+	}
+
+	for _, key := range keys {
+		value, err := json.Marshal(settings[key])
+		if err != nil {
+			return err
+		}
+		table.AddField(key, nil, nil)
+		table.AddField(string(value), nil, nil)
+		table.AddField(cmdutil.IndexSettingsSpecs[key].Description, nil, nil)
+		table.EndRow()
+	}
+
+	return table.Render()
 }
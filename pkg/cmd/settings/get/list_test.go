@@ -0,0 +1,68 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runListCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		wantOut string
+	}{
+		{
+			name:    "non-default",
+			cli:     "foo --non-default",
+			wantOut: "{\"customRanking\":[\"desc(year)\"],\"hitsPerPage\":50}\n",
+		},
+		{
+			name:  "explain",
+			cli:   "foo --non-default --explain",
+			isTTY: true,
+			wantOut: `SETTING        VALUE           DESCRIPTION
+customRanking  ["desc(year)"]  Attributes to use as custom ranking.
+hitsPerPage    50              Number of hits per page.
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("GET", "1/indexes/foo/settings"),
+				httpmock.JSONResponse(search.SettingsResponse{
+					HitsPerPage:         utils.ToPtr[int32](50),
+					MaxValuesPerFacet:   utils.ToPtr[int32](100),
+					CustomRanking:       []string{"desc(year)"},
+					AttributesToSnippet: []string{},
+					Distinct:            search.Int32AsDistinct(0),
+				}),
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewGetCmd(f)
+			out, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func TestNewGetCmd_explainWithOutput(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewGetCmd(f)
+	_, err := test.Execute(cmd, "foo --explain -o json", out)
+	assert.EqualError(t, err, "--explain can't be used with --output")
+}
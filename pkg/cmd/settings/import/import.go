@@ -8,6 +8,7 @@ import (
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	sharedconfig "github.com/algolia/cli/pkg/cmd/shared/config"
 	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
@@ -24,6 +25,9 @@ type ImportOptions struct {
 	Settings          search.IndexSettings
 	ForwardToReplicas bool
 	Wait              bool
+	SkipValidation    bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewImportCmd creates and returns an import command for settings
@@ -32,6 +36,7 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	var settingsFile string
@@ -44,6 +49,11 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 			"acls": "editSettings",
 		},
 		Short: "Import index settings from a file.",
+		Long: heredoc.Doc(`
+			Import index settings from a file.
+
+			The settings are validated before being imported, like with the "settings validate" command.
+		`),
 		Example: heredoc.Doc(`
 			# Import the settings from "settings.json" to the "MOVIES" index
 			$ algolia settings import MOVIES -F settings.json
@@ -54,6 +64,11 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if !opts.SkipValidation {
+				if err := sharedconfig.ValidateSettingsJSON(b); err != nil {
+					return err
+				}
+			}
 			err = json.Unmarshal(b, &opts.Settings)
 			if err != nil {
 				return err
@@ -67,6 +82,10 @@ func NewImportCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().
 		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", false, "Forward the settings to the replicas")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "wait for the operation to complete")
+	cmd.Flags().
+		BoolVar(&opts.SkipValidation, "skip-validation", false, "Import the settings without validating them first")
+
+	opts.PrintFlags.AddFlags(cmd)
 
 	return cmd
 }
@@ -98,6 +117,10 @@ func runImportCmd(opts *ImportOptions) error {
 
 	opts.IO.StopProgressIndicator()
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.Index, TaskID: res.TaskID}})
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(opts.IO.Out, "%s Imported settings on %v\n", cs.SuccessIcon(), opts.Index)
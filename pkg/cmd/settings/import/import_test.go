@@ -7,9 +7,11 @@ import (
 	"testing"
 
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algolia/cli/pkg/cmd/settings/get"
 	"github.com/algolia/cli/pkg/httpmock"
 	"github.com/algolia/cli/test"
 )
@@ -58,3 +60,72 @@ func Test_runExportCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runImportCmd_invalidSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "invalid settings",
+			cli:     "foo -F -",
+			wantErr: "invalid settings (1 error):\n  searchableAtributes: unknown setting, did you mean \"searchableAttributes\"?",
+		},
+		{
+			name: "skip validation",
+			cli:  "foo -F - --skip-validation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.wantErr == "" {
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/foo/settings"),
+					httpmock.JSONResponse(search.UpdatedAtResponse{}),
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(false, &r, nil, `{"searchableAtributes": ["title"]}`)
+			cmd := NewImportCmd(f)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_runImportCmd_replicaSettings(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo_replica/settings"),
+		httpmock.JSONResponse(search.SettingsResponse{
+			HitsPerPage: utils.ToPtr(int32(10)),
+			Primary:     utils.ToPtr("foo"),
+		}),
+	)
+	r.Register(
+		httpmock.REST("PUT", "1/indexes/foo_replica/settings"),
+		httpmock.JSONResponse(search.UpdatedAtResponse{}),
+	)
+	defer r.Verify(t)
+
+	// Export the settings of a replica, with its primary index
+	f, out := test.NewFactory(false, &r, nil, "")
+	out, err := test.Execute(get.NewGetCmd(f), "foo_replica", out)
+	require.NoError(t, err)
+	exported := out.String()
+	require.Contains(t, exported, `"primary":"foo"`)
+
+	// Import them back
+	f, out = test.NewFactory(true, &r, nil, exported)
+	out, err = test.Execute(NewImportCmd(f), "foo_replica -F -", out)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Imported settings on foo_replica\n", out.String())
+}
@@ -8,23 +8,28 @@ import (
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	"github.com/algolia/cli/pkg/cmd/shared/config"
 	"github.com/algolia/cli/pkg/cmdutil"
-	"github.com/algolia/cli/pkg/config"
+	cliconfig "github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
 	"github.com/algolia/cli/pkg/validators"
 )
 
 type SetOptions struct {
-	Config config.IConfig
+	Config cliconfig.IConfig
 	IO     *iostreams.IOStreams
 
 	SearchClient func() (*search.APIClient, error)
 
 	Settings          search.IndexSettings
+	Patch             map[string]interface{}
+	AppendArrays      bool
 	ForwardToReplicas bool
 	Wait              bool
 
 	Index string
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewSetCmd creates and returns a set command for settings
@@ -33,7 +38,10 @@ func NewSetCmd(f *cmdutil.Factory) *cobra.Command {
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
+	var patchFile string
+
 	cmd := &cobra.Command{
 		Use:  "set <index>",
 		Args: validators.ExactArgs(1),
@@ -41,9 +49,23 @@ func NewSetCmd(f *cmdutil.Factory) *cobra.Command {
 			"acls": "editSettings",
 		},
 		Short: "Specify index settings.",
+		Long: heredoc.Doc(`
+			Specify index settings, with one flag per setting or with a JSON merge patch (RFC 7386).
+
+			With --patch, the settings of the patch are merged into the current settings:
+			nested objects are merged, arrays are replaced (or appended with --append-arrays),
+			and null values reset the settings to their default value.
+			The changes of the settings are shown before and after the update.
+		`),
 		Example: heredoc.Doc(`
 			# Set the typo tolerance to false on the MOVIES index
 			$ algolia settings set MOVIES --typoTolerance="false"
+
+			# Apply a JSON merge patch to the settings of the MOVIES index
+			$ algolia settings set MOVIES --patch patch.json
+
+			# Add attributes to the searchable attributes of the MOVIES index
+			$ echo '{"searchableAttributes": ["actors"]}' | algolia settings set MOVIES --patch - --append-arrays
 		`),
 		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,6 +76,23 @@ func NewSetCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			if patchFile != "" {
+				if len(settings) > 0 {
+					return cmdutil.FlagErrorf("--patch can't be used with the setting flags")
+				}
+				b, err := cmdutil.ReadFile(patchFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				if err := json.Unmarshal(b, &opts.Patch); err != nil {
+					return fmt.Errorf("failed to parse the patch, it must be a JSON object: %w", err)
+				}
+				return runPatchCmd(opts)
+			}
+			if opts.AppendArrays {
+				return cmdutil.FlagErrorf("--append-arrays requires --patch")
+			}
+
 			// Serialize / Deseralize the settings
 			tmp, err := json.Marshal(settings)
 			if err != nil {
@@ -71,9 +110,15 @@ func NewSetCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().
 		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", false, "Whether to apply settings changes also to replicas")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
+	cmd.Flags().
+		StringVar(&patchFile, "patch", "", "Apply the JSON merge patch of this `file` to the settings (use \"-\" to read from the standard input)")
+	cmd.Flags().
+		BoolVar(&opts.AppendArrays, "append-arrays", false, "Append the arrays of the patch to the current settings instead of replacing them")
 
 	cmdutil.AddIndexSettingsFlags(cmd)
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -107,6 +152,10 @@ func runSetCmd(opts *SetOptions) error {
 
 	opts.IO.StopProgressIndicator()
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.Index, TaskID: res.TaskID}})
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(opts.IO.Out, "%s Set settings on %v\n", cs.SuccessIcon(), opts.Index)
@@ -114,3 +163,78 @@ func runSetCmd(opts *SetOptions) error {
 
 	return nil
 }
+
+func runPatchCmd(opts *SetOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(
+		fmt.Sprintf("Fetching settings for index %s", opts.Index),
+	)
+	res, err := client.GetSettings(client.NewApiGetSettingsRequest(opts.Index))
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	current, err := config.ToMap(res)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	patched := config.MergePatch(current, opts.Patch, opts.AppendArrays)
+	keys := make([]string, 0, len(opts.Patch))
+	for key := range opts.Patch {
+		keys = append(keys, key)
+	}
+	changes := config.DiffKeys(current, patched, keys)
+
+	var tasks []cmdutil.Task
+	if len(changes) > 0 {
+		// Only the changed settings are sent, null values reset them to their default value
+		settings := make(map[string]interface{}, len(changes))
+		for _, c := range changes {
+			settings[c.Key] = patched[c.Key]
+		}
+
+		opts.IO.UpdateProgressIndicatorLabel(
+			fmt.Sprintf("Setting settings for index %s", opts.Index),
+		)
+		taskID, err := config.SetRawSettings(client, opts.Index, settings, opts.ForwardToReplicas)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		tasks = append(tasks, cmdutil.Task{Index: opts.Index, TaskID: taskID})
+
+		if opts.Wait {
+			opts.IO.UpdateProgressIndicatorLabel("Waiting for the task to complete")
+			_, err := client.WaitForTask(opts.Index, taskID)
+			if err != nil {
+				opts.IO.StopProgressIndicator()
+				return err
+			}
+		}
+	}
+
+	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, tasks)
+	}
+
+	config.PrintChanges(opts.IO, "", changes)
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		if len(changes) == 0 {
+			fmt.Fprintf(opts.IO.Out, "%s Settings of %v are already up to date\n", cs.SuccessIcon(), opts.Index)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s Set settings on %v\n", cs.SuccessIcon(), opts.Index)
+		}
+	}
+
+	return nil
+}
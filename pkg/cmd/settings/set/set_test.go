@@ -1,10 +1,13 @@
 package set
 
 import (
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algolia/cli/pkg/httpmock"
 	"github.com/algolia/cli/test"
@@ -48,3 +51,76 @@ func Test_runSetCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runPatchCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		patch     string
+		wantBody  string
+		wantOut   string
+		noChanges bool
+	}{
+		{
+			name:     "replace arrays and reset settings",
+			cli:      "foo --patch -",
+			patch:    `{"searchableAttributes": ["actors"], "distinct": null}`,
+			wantBody: `{"distinct":null,"searchableAttributes":["actors"]}`,
+			wantOut: `- distinct: 1
+~ searchableAttributes: ["title"] → ["actors"]
+✓ Set settings on foo
+`,
+		},
+		{
+			name:     "append arrays",
+			cli:      "foo --patch - --append-arrays",
+			patch:    `{"searchableAttributes": ["title", "actors"]}`,
+			wantBody: `{"searchableAttributes":["title","actors"]}`,
+			wantOut: `~ searchableAttributes: ["title"] → ["title","actors"]
+✓ Set settings on foo
+`,
+		},
+		{
+			name:      "no changes",
+			cli:       "foo --patch -",
+			patch:     `{"searchableAttributes": ["title"], "customRanking": null}`,
+			wantOut:   "✓ Settings of foo are already up to date\n",
+			noChanges: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("GET", "1/indexes/foo/settings"),
+				httpmock.JSONResponse(search.SettingsResponse{
+					SearchableAttributes: []string{"title"},
+					Distinct:             search.Int32AsDistinct(1),
+				}),
+			)
+			var body string
+			if !tt.noChanges {
+				r.Register(
+					httpmock.REST("PUT", "1/indexes/foo/settings"),
+					func(req *http.Request) (*http.Response, error) {
+						b, _ := io.ReadAll(req.Body)
+						body = string(b)
+						return httpmock.JSONResponse(search.UpdatedAtResponse{TaskID: 42})(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(true, &r, nil, tt.patch)
+			cmd := NewSetCmd(f)
+			out, err := test.Execute(cmd, tt.cli, out)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, out.String())
+			if !tt.noChanges {
+				assert.JSONEq(t, tt.wantBody, body)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/algolia/cli/pkg/cmd/settings/get"
 	importSettings "github.com/algolia/cli/pkg/cmd/settings/import"
 	"github.com/algolia/cli/pkg/cmd/settings/set"
+	"github.com/algolia/cli/pkg/cmd/settings/unset"
+	"github.com/algolia/cli/pkg/cmd/settings/validate"
 	"github.com/algolia/cli/pkg/cmdutil"
 )
 
@@ -18,6 +20,8 @@ func NewSettingsCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(get.NewGetCmd(f))
 	cmd.AddCommand(set.NewSetCmd(f))
+	cmd.AddCommand(unset.NewUnsetCmd(f, nil))
+	cmd.AddCommand(validate.NewValidateCmd(f, nil))
 	cmd.AddCommand(importSettings.NewImportCmd(f))
 
 	return cmd
@@ -0,0 +1,148 @@
+package unset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/shared/config"
+	"github.com/algolia/cli/pkg/cmdutil"
+	cliconfig "github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type UnsetOptions struct {
+	Config cliconfig.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index             string
+	Keys              []string
+	ForwardToReplicas bool
+	Wait              bool
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewUnsetCmd creates and returns an unset command for settings
+func NewUnsetCmd(f *cmdutil.Factory, runF func(*UnsetOptions) error) *cobra.Command {
+	opts := &UnsetOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+	cmd := &cobra.Command{
+		Use:  "unset <index> <setting>...",
+		Args: validators.AtLeastNArgs(2),
+		Annotations: map[string]string{
+			"acls": "editSettings",
+		},
+		Short: "Reset index settings to their default value.",
+		Example: heredoc.Doc(`
+			# Reset the custom ranking and the distinct settings of the MOVIES index
+			$ algolia settings unset MOVIES customRanking distinct
+		`),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return cmdutil.IndexNames(opts.SearchClient)(cmd, args, toComplete)
+			}
+			return utils.Differences(cmdutil.IndexSettings, args[1:]), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			opts.Keys = args[1:]
+
+			for _, key := range opts.Keys {
+				if !utils.Contains(cmdutil.IndexSettings, key) {
+					return cmdutil.FlagErrorf("unknown setting %q", key)
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runUnsetCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", false, "Whether to apply settings changes also to replicas")
+	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runUnsetCmd(opts *UnsetOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(
+		fmt.Sprintf("Fetching settings for index %s", opts.Index),
+	)
+	res, err := client.GetSettings(client.NewApiGetSettingsRequest(opts.Index))
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	current, err := config.ToMap(res)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	settings := make(map[string]interface{}, len(opts.Keys))
+	for _, key := range opts.Keys {
+		settings[key] = nil
+	}
+	changes := config.DiffKeys(current, config.MergePatch(current, settings, false), opts.Keys)
+
+	opts.IO.UpdateProgressIndicatorLabel(
+		fmt.Sprintf("Resetting settings for index %s", opts.Index),
+	)
+	taskID, err := config.SetRawSettings(client, opts.Index, settings, opts.ForwardToReplicas)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	if opts.Wait {
+		opts.IO.UpdateProgressIndicatorLabel("Waiting for the task to complete")
+		_, err := client.WaitForTask(opts.Index, taskID)
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+	}
+
+	opts.IO.StopProgressIndicator()
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, []cmdutil.Task{{Index: opts.Index, TaskID: taskID}})
+	}
+
+	config.PrintChanges(opts.IO, "", changes)
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(
+			opts.IO.Out,
+			"%s Reset %s on %v\n",
+			cs.SuccessIcon(),
+			utils.Pluralize(len(opts.Keys), "setting"),
+			opts.Index,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package unset
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runUnsetCmd(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo/settings"),
+		httpmock.JSONResponse(search.SettingsResponse{CustomRanking: []string{"desc(year)"}}),
+	)
+	var body string
+	r.Register(
+		httpmock.REST("PUT", "1/indexes/foo/settings"),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.JSONResponse(search.UpdatedAtResponse{TaskID: 42})(req)
+		},
+	)
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(true, &r, nil, "")
+	cmd := NewUnsetCmd(f, nil)
+	out, err := test.Execute(cmd, "foo customRanking distinct", out)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"customRanking":null,"distinct":null}`, body)
+	assert.Equal(t, "- customRanking: [\"desc(year)\"]\n✓ Reset 2 settings on foo\n", out.String())
+}
+
+func TestNewUnsetCmd_unknownSetting(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewUnsetCmd(f, func(*UnsetOptions) error { return nil })
+	_, err := test.Execute(cmd, "foo customRankin", out)
+	assert.EqualError(t, err, "unknown setting \"customRankin\"")
+}
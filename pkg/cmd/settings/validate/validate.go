@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/auth"
+	"github.com/algolia/cli/pkg/cmd/shared/config"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+type ValidateOptions struct {
+	IO *iostreams.IOStreams
+
+	File     string
+	Settings []byte
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewValidateCmd creates and returns a validate command for settings
+func NewValidateCmd(f *cmdutil.Factory, runF func(*ValidateOptions) error) *cobra.Command {
+	opts := &ValidateOptions{
+		IO:         f.IOStreams,
+		PrintFlags: cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "validate -F <file>",
+		Args:  cobra.NoArgs,
+		Short: "Validate index settings from a file, without sending them to Algolia.",
+		Long: heredoc.Doc(`
+			Validate index settings from a file, without sending them to Algolia.
+
+			The settings are checked against the API specification:
+			unknown settings, types, allowed values and attribute modifiers such as unordered() or searchable().
+			The command exits with a non-zero status if the settings are invalid.
+		`),
+		Example: heredoc.Doc(`
+			# Validate the settings of the "settings.json" file
+			$ algolia settings validate -F settings.json
+
+			# Validate the settings of an index before importing them to another one
+			$ algolia settings get MOVIES | algolia settings validate -F -
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			b, err := cmdutil.ReadFile(opts.File, opts.IO.In)
+			if err != nil {
+				return err
+			}
+			opts.Settings = b
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runValidateCmd(opts)
+		},
+	}
+
+	auth.DisableAuthCheck(cmd)
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Validate the settings of a `file` (use \"-\" to read from standard input)")
+	_ = cmd.MarkFlagRequired("file")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runValidateCmd(opts *ValidateOptions) error {
+	err := config.ValidateSettingsJSON(opts.Settings)
+	var settingErrors config.SettingErrors
+	if err != nil && !errors.As(err, &settingErrors) {
+		return err
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if settingErrors == nil {
+			settingErrors = config.SettingErrors{}
+		}
+		if err := p.Print(opts.IO, settingErrors); err != nil {
+			return err
+		}
+	} else {
+		cs := opts.IO.ColorScheme()
+		for _, e := range settingErrors {
+			fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), cs.Bold(e.Setting), e.Message)
+		}
+		if len(settingErrors) == 0 && opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s The settings are valid\n", cs.SuccessIcon())
+		}
+	}
+
+	if len(settingErrors) > 0 {
+		return cmdutil.ErrSilent
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runValidateCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		isTTY   bool
+		wantOut string
+		wantErr error
+	}{
+		{
+			name:    "valid settings",
+			cli:     "-F -",
+			stdin:   `{"searchableAttributes": ["unordered(title)"], "customRanking": ["desc(year)"]}`,
+			isTTY:   true,
+			wantOut: "✓ The settings are valid\n",
+		},
+		{
+			name:  "invalid settings",
+			cli:   "-F -",
+			stdin: `{"searchableAtributes": ["title"], "customRanking": ["year"]}`,
+			wantOut: "X customRanking[0]: \"year\" must be asc(attribute) or desc(attribute)\n" +
+				"X searchableAtributes: unknown setting, did you mean \"searchableAttributes\"?\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name:    "JSON output",
+			cli:     "-F - -o json",
+			stdin:   `{"hitsPerPage": "20"}`,
+			wantOut: "[{\"setting\":\"hitsPerPage\",\"message\":\"expected integer, got string\"}]\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, out := test.NewFactory(tt.isTTY, nil, nil, tt.stdin)
+			cmd := NewValidateCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantOut, out.OutBuf.String())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+// ChangeKind is an enum for the different kinds of configuration changes.
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Changed ChangeKind = "changed"
+	Removed ChangeKind = "removed"
+)
+
+// Change is a difference between the current and the expected configuration of an index.
+type Change struct {
+	Kind   ChangeKind  `json:"kind"`
+	Key    string      `json:"key"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// ToMap converts a configuration object (settings, rule, synonym) to a map of its JSON attributes.
+func ToMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DiffKeys compares the given keys of two configuration maps.
+// A key missing from the map after the change is considered removed (reset to its default value).
+func DiffKeys(before, after map[string]interface{}, keys []string) []Change {
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+
+	changes := []Change{}
+	for _, key := range sorted {
+		b, inBefore := before[key]
+		a, inAfter := after[key]
+		switch {
+		case !inBefore && !inAfter:
+		case !inBefore:
+			changes = append(changes, Change{Kind: Added, Key: key, After: a})
+		case !inAfter:
+			changes = append(changes, Change{Kind: Removed, Key: key, Before: b})
+		case !reflect.DeepEqual(a, b):
+			changes = append(changes, Change{Kind: Changed, Key: key, Before: b, After: a})
+		}
+	}
+	return changes
+}
+
+// DiffSettings compares the settings of the template with the current settings of an index.
+// Only the settings defined in the template are compared.
+func DiffSettings(current *search.SettingsResponse, expected *search.IndexSettings) ([]Change, error) {
+	before, err := ToMap(current)
+	if err != nil {
+		return nil, err
+	}
+	after, err := ToMap(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(after))
+	for key := range after {
+		keys = append(keys, key)
+	}
+	return DiffKeys(before, after, keys), nil
+}
+
+// DiffObjects compares the rules or synonyms of a template with the ones of an index, by objectID.
+// Only the attributes defined in the template are compared,
+// and the objects of the index that aren't in the template are ignored.
+func DiffObjects(prefix string, current, expected []map[string]interface{}) []Change {
+	byID := make(map[string]map[string]interface{}, len(current))
+	for _, o := range current {
+		if id, ok := o["objectID"].(string); ok {
+			byID[id] = o
+		}
+	}
+
+	changes := []Change{}
+	for _, o := range expected {
+		id, _ := o["objectID"].(string)
+		key := fmt.Sprintf("%s/%s", prefix, id)
+		existing, ok := byID[id]
+		if !ok {
+			changes = append(changes, Change{Kind: Added, Key: key})
+			continue
+		}
+		for attribute, value := range o {
+			if !reflect.DeepEqual(existing[attribute], value) {
+				changes = append(changes, Change{Kind: Changed, Key: key})
+				break
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// PrintChanges prints a human-readable diff of configuration changes.
+func PrintChanges(io *iostreams.IOStreams, indent string, changes []Change) {
+	cs := io.ColorScheme()
+	for _, c := range changes {
+		switch c.Kind {
+		case Added:
+			if c.After == nil {
+				fmt.Fprintf(io.Out, "%s%s\n", indent, cs.Green("+ "+c.Key))
+			} else {
+				fmt.Fprintf(io.Out, "%s%s\n", indent, cs.Greenf("+ %s: %s", c.Key, formatValue(c.After)))
+			}
+		case Removed:
+			if c.Before == nil {
+				fmt.Fprintf(io.Out, "%s%s\n", indent, cs.Red("- "+c.Key))
+			} else {
+				fmt.Fprintf(io.Out, "%s%s\n", indent, cs.Redf("- %s: %s", c.Key, formatValue(c.Before)))
+			}
+		case Changed:
+			if c.Before == nil && c.After == nil {
+				fmt.Fprintf(io.Out, "%s%s\n", indent, cs.Yellow("~ "+c.Key))
+			} else {
+				fmt.Fprintf(
+					io.Out,
+					"%s%s\n",
+					indent,
+					cs.Yellowf("~ %s: %s → %s", c.Key, formatValue(c.Before), formatValue(c.After)),
+				)
+			}
+		}
+	}
+}
+
+// formatValue formats a setting value as compact JSON.
+func formatValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// MergePatch applies a JSON merge patch (RFC 7386) to a copy of the target, and returns it.
+// Null values in the patch remove the keys from the target.
+// If appendArrays is true, the arrays of the patch are appended to the arrays of the target
+// (skipping the values already present) instead of replacing them.
+func MergePatch(target, patch map[string]interface{}, appendArrays bool) map[string]interface{} {
+	result := make(map[string]interface{}, len(target))
+	for key, value := range target {
+		result[key] = value
+	}
+
+	for key, value := range patch {
+		switch v := value.(type) {
+		case nil:
+			delete(result, key)
+		case map[string]interface{}:
+			current, _ := result[key].(map[string]interface{})
+			result[key] = MergePatch(current, v, appendArrays)
+		case []interface{}:
+			current, ok := result[key].([]interface{})
+			if !appendArrays || !ok {
+				result[key] = v
+				continue
+			}
+			merged := append([]interface{}{}, current...)
+			for _, e := range v {
+				if !containsValue(merged, e) {
+					merged = append(merged, e)
+				}
+			}
+			result[key] = merged
+		default:
+			result[key] = v
+		}
+	}
+
+	return result
+}
+
+// containsValue returns true if the slice contains a value deeply equal to the given one
+func containsValue(s []interface{}, v interface{}) bool {
+	for _, e := range s {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRawSettings sets the given settings on an index, and returns the ID of the task.
+// Unlike `SetSettings` of the API client, null values are sent to reset the settings to their default value.
+func SetRawSettings(
+	client *search.APIClient,
+	index string,
+	settings map[string]interface{},
+	forwardToReplicas bool,
+) (int64, error) {
+	res, err := client.CustomPut(
+		client.NewApiCustomPutRequest(fmt.Sprintf("1/indexes/%s/settings", url.PathEscape(index))).
+			WithParameters(map[string]any{"forwardToReplicas": forwardToReplicas}).
+			WithBody(settings),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var taskID int64
+	if res != nil {
+		if id, ok := (*res)["taskID"].(float64); ok {
+			taskID = int64(id)
+		}
+	}
+	return taskID, nil
+}
@@ -0,0 +1,271 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// SettingError is an invalid index setting.
+type SettingError struct {
+	Setting string `json:"setting"`
+	Message string `json:"message"`
+}
+
+func (e SettingError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Setting, e.Message)
+}
+
+// SettingErrors are the errors found when validating index settings.
+type SettingErrors []SettingError
+
+func (e SettingErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, "  "+err.Error())
+	}
+	return fmt.Sprintf(
+		"invalid settings (%s):\n%s",
+		utils.Pluralize(len(e), "error"),
+		strings.Join(lines, "\n"),
+	)
+}
+
+// attributeModifiers are the modifiers allowed in the attributes of each setting.
+var attributeModifiers = map[string][]string{
+	"searchableAttributes":          {"unordered"},
+	"attributesForFaceting":         {"searchable", "filterOnly", "afterDistinct"},
+	"customRanking":                 {"asc", "desc"},
+	"ranking":                       {"asc", "desc"},
+	"numericAttributesForFiltering": {"equalOnly"},
+}
+
+// rankingCriteria are the built-in ranking criteria.
+var rankingCriteria = []string{
+	"typo",
+	"geo",
+	"words",
+	"filters",
+	"proximity",
+	"attribute",
+	"exact",
+	"custom",
+}
+
+// responseOnlySettings are returned by the API with the settings of an index, but can't be set.
+// They're in exported settings, so they're ignored, like the API client does when importing them.
+var responseOnlySettings = []string{
+	"primary",
+}
+
+var modifierRegexp = regexp.MustCompile(`^(\w+)\((.*)\)$`)
+
+// ValidateSettingsJSON parses and validates JSON-encoded index settings.
+// The returned error is a SettingErrors if the settings are invalid.
+func ValidateSettingsJSON(b []byte) error {
+	var settings map[string]interface{}
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return fmt.Errorf("failed to parse the settings, they must be a JSON object: %w", err)
+	}
+	if errs := ValidateSettings(settings); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateSettings checks index settings against the specs of the API:
+// unknown settings, types, allowed values and attribute modifiers.
+// Settings that are only in API responses, like primary, are ignored.
+func ValidateSettings(settings map[string]interface{}) SettingErrors {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	known := make([]string, 0, len(cmdutil.IndexSettingsSpecs))
+	for key := range cmdutil.IndexSettingsSpecs {
+		known = append(known, key)
+	}
+	sort.Strings(known)
+
+	var errs SettingErrors
+	for _, key := range keys {
+		if utils.Contains(responseOnlySettings, key) {
+			continue
+		}
+		value := settings[key]
+		spec, ok := cmdutil.IndexSettingsSpecs[key]
+		if !ok {
+			errs = append(errs, SettingError{Setting: key, Message: "unknown setting" + didYouMean(key, known)})
+			continue
+		}
+		// Null resets the setting to its default value
+		if value == nil {
+			continue
+		}
+		errs = append(errs, validateValue(key, value, spec.Types, spec.Enum)...)
+
+		values, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		for i, v := range values {
+			path := fmt.Sprintf("%s[%d]", key, i)
+			itemErrs := validateValue(path, v, spec.ItemTypes, spec.ItemEnum)
+			errs = append(errs, itemErrs...)
+			if s, ok := v.(string); ok && len(itemErrs) == 0 {
+				if err := validateAttribute(key, s); err != "" {
+					errs = append(errs, SettingError{Setting: path, Message: err})
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateValue checks the type and the allowed values of a value.
+func validateValue(path string, value interface{}, types []string, enum []string) SettingErrors {
+	actual := jsonType(value)
+	if !typeAllowed(actual, types) {
+		return SettingErrors{{
+			Setting: path,
+			Message: fmt.Sprintf("expected %s, got %s", strings.Join(types, " or "), actual),
+		}}
+	}
+	if s, ok := value.(string); ok && len(enum) > 0 && !utils.Contains(enum, s) {
+		return SettingErrors{{
+			Setting: path,
+			Message: fmt.Sprintf(
+				"invalid value %q, expected one of: %s%s",
+				s,
+				strings.Join(enum, ", "),
+				didYouMean(s, enum),
+			),
+		}}
+	}
+	return nil
+}
+
+// validateAttribute checks the modifiers of an attribute of a setting,
+// and returns an error message if the attribute is invalid.
+func validateAttribute(setting string, attribute string) string {
+	modifiers, ok := attributeModifiers[setting]
+	if !ok {
+		return ""
+	}
+
+	match := modifierRegexp.FindStringSubmatch(attribute)
+	if match == nil {
+		switch setting {
+		case "customRanking":
+			return fmt.Sprintf("%q must be asc(attribute) or desc(attribute)", attribute)
+		case "ranking":
+			if !utils.Contains(rankingCriteria, attribute) {
+				return fmt.Sprintf(
+					"unknown ranking criterion %q, expected one of: %s, asc(attribute), desc(attribute)%s",
+					attribute,
+					strings.Join(rankingCriteria, ", "),
+					didYouMean(attribute, rankingCriteria),
+				)
+			}
+		}
+		return ""
+	}
+
+	modifier, inner := match[1], strings.TrimSpace(match[2])
+	if !utils.Contains(modifiers, modifier) {
+		return fmt.Sprintf(
+			"unknown modifier %q, expected one of: %s%s",
+			modifier,
+			strings.Join(modifiers, ", "),
+			didYouMean(modifier, modifiers),
+		)
+	}
+	if inner == "" {
+		return fmt.Sprintf("missing attribute in %q", attribute)
+	}
+	// afterDistinct can be combined with searchable and filterOnly
+	if modifier == "afterDistinct" && modifierRegexp.MatchString(inner) {
+		return validateAttribute(setting, inner)
+	}
+	if modifierRegexp.MatchString(inner) {
+		return fmt.Sprintf("nested modifiers aren't allowed in %q", attribute)
+	}
+	return ""
+}
+
+// jsonType returns the JSON type of a decoded JSON value.
+func jsonType(v interface{}) string {
+	switch n := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// typeAllowed returns true if the JSON type is allowed (integers are also numbers).
+// An empty list of types allows any type.
+func typeAllowed(actual string, types []string) bool {
+	if len(types) == 0 || utils.Contains(types, actual) {
+		return true
+	}
+	return actual == "integer" && utils.Contains(types, "number")
+}
+
+// didYouMean returns a suggestion for a misspelled value, or an empty string if no candidate is close enough.
+func didYouMean(value string, candidates []string) string {
+	best, bestDistance := "", math.MaxInt
+	for _, c := range candidates {
+		d := levenshtein(strings.ToLower(value), strings.ToLower(c))
+		if d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	if best == "" || bestDistance > 3 || bestDistance*2 > len(value) {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %q?", best)
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]interface{}
+		want     SettingErrors
+	}{
+		{
+			name: "valid settings",
+			settings: map[string]interface{}{
+				"searchableAttributes":  []interface{}{"title,alt_title", "unordered(body)"},
+				"attributesForFaceting": []interface{}{"searchable(brand)", "afterDistinct(filterOnly(color))"},
+				"customRanking":         []interface{}{"desc(year)"},
+				"ranking":               []interface{}{"typo", "asc(price)", "custom"},
+				"hitsPerPage":           float64(20),
+				"typoTolerance":         "min",
+				"distinct":              true,
+				"userData":              map[string]interface{}{"foo": "bar"},
+				"customNormalization":   nil,
+			},
+		},
+		{
+			name:     "unknown setting",
+			settings: map[string]interface{}{"searchableAtributes": []interface{}{"title"}},
+			want: SettingErrors{
+				{Setting: "searchableAtributes", Message: `unknown setting, did you mean "searchableAttributes"?`},
+			},
+		},
+		{
+			name:     "unknown setting without suggestion",
+			settings: map[string]interface{}{"foo": true},
+			want:     SettingErrors{{Setting: "foo", Message: "unknown setting"}},
+		},
+		{
+			name: "invalid types",
+			settings: map[string]interface{}{
+				"hitsPerPage":   "20",
+				"distinct":      1.5,
+				"customRanking": []interface{}{float64(1)},
+			},
+			want: SettingErrors{
+				{Setting: "customRanking[0]", Message: "expected string, got integer"},
+				{Setting: "distinct", Message: "expected boolean or integer, got number"},
+				{Setting: "hitsPerPage", Message: "expected integer, got string"},
+			},
+		},
+		{
+			name: "invalid enum values",
+			settings: map[string]interface{}{
+				"queryType":       "prefixLats",
+				"typoTolerance":   true,
+				"removeStopWords": []interface{}{"fr"},
+			},
+			want: SettingErrors{
+				{
+					Setting: "queryType",
+					Message: `invalid value "prefixLats", expected one of: prefixLast, prefixAll, prefixNone, did you mean "prefixLast"?`,
+				},
+			},
+		},
+		{
+			name: "invalid modifiers",
+			settings: map[string]interface{}{
+				"searchableAttributes":  []interface{}{"unorderd(title)", "unordered()"},
+				"attributesForFaceting": []interface{}{"searchable(unordered(brand))"},
+				"customRanking":         []interface{}{"year"},
+				"ranking":               []interface{}{"typos"},
+			},
+			want: SettingErrors{
+				{Setting: "attributesForFaceting[0]", Message: `nested modifiers aren't allowed in "searchable(unordered(brand))"`},
+				{Setting: "customRanking[0]", Message: `"year" must be asc(attribute) or desc(attribute)`},
+				{
+					Setting: "ranking[0]",
+					Message: `unknown ranking criterion "typos", expected one of: typo, geo, words, filters, proximity, attribute, exact, custom, asc(attribute), desc(attribute), did you mean "typo"?`,
+				},
+				{
+					Setting: "searchableAttributes[0]",
+					Message: `unknown modifier "unorderd", expected one of: unordered, did you mean "unordered"?`,
+				},
+				{Setting: "searchableAttributes[1]", Message: `missing attribute in "unordered()"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidateSettings(tt.settings))
+		})
+	}
+}
+
+func TestValidateSettingsJSON(t *testing.T) {
+	assert.NoError(t, ValidateSettingsJSON([]byte(`{"hitsPerPage": 10}`)))
+	assert.EqualError(
+		t,
+		ValidateSettingsJSON([]byte(`{"hitPerPage": 10}`)),
+		"invalid settings (1 error):\n  hitPerPage: unknown setting, did you mean \"hitsPerPage\"?",
+	)
+	assert.Error(t, ValidateSettingsJSON([]byte(`[]`)))
+}
+
+func TestValidateSettingsJSON_replica(t *testing.T) {
+	// The settings of a replica have its primary index, which can't be set but is ignored
+	assert.NoError(t, ValidateSettingsJSON([]byte(`{"hitsPerPage": 10, "primary": "MOVIES"}`)))
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	rulesShared "github.com/algolia/cli/pkg/cmd/rules/shared"
 	config "github.com/algolia/cli/pkg/cmd/shared/handler/indices"
+	rules "github.com/algolia/cli/pkg/cmd/shared/handler/rules"
 	synonyms "github.com/algolia/cli/pkg/cmd/shared/handler/synonyms"
 	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
 	"github.com/spf13/cobra"
@@ -40,6 +42,25 @@ func (handler *SynonymHandler) AskAndFill() error {
 	return synonyms.ValidateSynonymFlags(*handler.Flags)
 }
 
+// `rules save`
+type RuleHandler struct {
+	Flags *rulesShared.RuleFlags
+	Cmd   *cobra.Command
+}
+
+func (handler RuleHandler) Validate() error {
+	return rules.ValidateRuleFlags(*handler.Flags)
+}
+
+func (handler *RuleHandler) AskAndFill() error {
+	err := rules.AskRule(handler.Flags, handler.Cmd)
+	if err != nil {
+		return err
+	}
+
+	return rules.ValidateRuleFlags(*handler.Flags)
+}
+
 // `indices config export`
 type IndexConfigExportHandler struct {
 	Opts *config.ExportOptions
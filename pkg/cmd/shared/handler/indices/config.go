@@ -7,12 +7,15 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 
 	"github.com/algolia/cli/pkg/ask"
+	sharedconfig "github.com/algolia/cli/pkg/cmd/shared/config"
+	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
 	"github.com/algolia/cli/pkg/utils"
@@ -26,6 +29,7 @@ type ExportOptions struct {
 	Index     string
 	Scope     []string
 	Directory string
+	Format    string
 
 	SearchClient func() (*search.APIClient, error)
 }
@@ -62,18 +66,20 @@ func AskExportConfig(opts *ExportOptions) error {
 
 // Matching Algolia Dashboard file naming
 // https://github.com/algolia/AlgoliaWeb/blob/develop/_client/src/routes/explorer/components/Explorer/IndexExportSettingsModal.tsx#L88
-func GetConfigFileName(path string, indexName string, appID string) string {
+// The extension of the file is the format of the config (json or yaml).
+func GetConfigFileName(path string, indexName string, appID string, format string) string {
 	rootPath := ""
 	if path != "" {
 		rootPath = path + "/"
 	}
 
 	return fmt.Sprintf(
-		"%sexport-%s-%s-%s.json",
+		"%sexport-%s-%s-%s.%s",
 		rootPath,
 		indexName,
 		appID,
 		strconv.FormatInt(time.Now().UTC().Unix(), 10),
+		format,
 	)
 }
 
@@ -87,6 +93,7 @@ type ImportOptions struct {
 
 	Index                 string
 	FilePath              string
+	Format                string
 	Scope                 []string
 	ClearExistingSynonyms bool
 	ClearExistingRules    bool
@@ -95,7 +102,8 @@ type ImportOptions struct {
 	ForwardSynonymsToReplicas bool
 	ForwardRulesToReplicas    bool
 
-	DoConfirm bool
+	SkipValidation bool
+	DoConfirm      bool
 }
 
 type ImportConfigJSON struct {
@@ -111,7 +119,7 @@ func ValidateImportConfigFlags(opts *ImportOptions) error {
 		return fmt.Errorf("%s Config file is required", cs.FailureIcon())
 	}
 
-	config, err := readConfigFromFile(cs, opts.FilePath)
+	config, err := readConfigFromFile(cs, opts.FilePath, opts.Format, !opts.SkipValidation)
 	if err != nil {
 		return err
 	}
@@ -150,7 +158,7 @@ func ValidateImportConfigFlags(opts *ImportOptions) error {
 func AskImportConfig(opts *ImportOptions) error {
 	// Validate file path
 	err := ask.AskInputQuestionWithSuggestion(
-		"file (path of the .json config file)",
+		"file (path of the .json or .yaml config file)",
 		&opts.FilePath,
 		opts.FilePath,
 		func(toComplete string) []string {
@@ -162,7 +170,12 @@ func AskImportConfig(opts *ImportOptions) error {
 	if err != nil {
 		return err
 	}
-	config, err := readConfigFromFile(opts.IO.ColorScheme(), opts.FilePath)
+	config, err := readConfigFromFile(
+		opts.IO.ColorScheme(),
+		opts.FilePath,
+		opts.Format,
+		!opts.SkipValidation,
+	)
 	if err != nil {
 		return err
 	}
@@ -241,30 +254,74 @@ func AskImportConfig(opts *ImportOptions) error {
 	return nil
 }
 
-func readConfigFromFile(cs *iostreams.ColorScheme, filePath string) (*ImportConfigJSON, error) {
+// readConfigFromFile reads the config of an index from a JSON or YAML file.
+// The format is detected from the extension of the file, unless it's given.
+// If validate is true, the settings are validated against the API spec.
+func readConfigFromFile(
+	cs *iostreams.ColorScheme,
+	filePath string,
+	format string,
+	validate bool,
+) (*ImportConfigJSON, error) {
 	var config *ImportConfigJSON
 
-	jsonFile, err := os.Open(filePath)
+	format, err := cmdutil.FileFormat(format, filePath, "json", "yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("%s An error occurred when opening file: %w", cs.FailureIcon(), err)
 	}
-	defer jsonFile.Close()
-	byteValue, err := io.ReadAll(jsonFile)
+	defer file.Close()
+	byteValue, err := io.ReadAll(file)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"%s An error occurred when reading JSON file: %w",
+			"%s An error occurred when reading %s file: %w",
 			cs.FailureIcon(),
+			strings.ToUpper(format),
 			err,
 		)
 	}
+	if format == "yaml" {
+		docs, err := utils.YAMLToJSON(byteValue)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%s An error occurred when parsing YAML file: %w",
+				cs.FailureIcon(),
+				err,
+			)
+		}
+		if len(docs) != 1 {
+			return nil, fmt.Errorf(
+				"%s The YAML file must contain exactly 1 document, found %d",
+				cs.FailureIcon(),
+				len(docs),
+			)
+		}
+		byteValue = docs[0]
+	}
 	err = json.Unmarshal(byteValue, &config)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"%s An error occurred when parsing JSON file: %w",
+			"%s An error occurred when parsing %s file: %w",
 			cs.FailureIcon(),
+			strings.ToUpper(format),
 			err,
 		)
 	}
 
+	if validate {
+		var raw struct {
+			Settings json.RawMessage `json:"settings"`
+		}
+		if err := json.Unmarshal(byteValue, &raw); err == nil && len(raw.Settings) > 0 {
+			if err := sharedconfig.ValidateSettingsJSON(raw.Settings); err != nil {
+				return nil, fmt.Errorf("%s %w", cs.FailureIcon(), err)
+			}
+		}
+	}
+
 	return config, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/algolia/cli/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_ValidateExportConfigFlags(t *testing.T) {
@@ -103,6 +104,24 @@ func Test_ValidateImportConfigFlags(t *testing.T) {
 			wantsErr:    true,
 			wantsErrMsg: "X Cannot clear existing synonyms if synonyms are not in scope",
 		},
+		{
+			name: "Import rules and synonyms from a YAML file",
+			opts: ImportOptions{
+				Scope:    []string{"rules", "synonyms"},
+				FilePath: "test_artifacts/config_mock.yaml",
+			},
+			wantsErr: false,
+		},
+		{
+			name: "Import a YAML file as JSON",
+			opts: ImportOptions{
+				Scope:    []string{"rules"},
+				FilePath: "test_artifacts/config_mock.yaml",
+				Format:   "json",
+			},
+			wantsErr:    true,
+			wantsErrMsg: "X An error occurred when parsing JSON file: invalid character 'r' looking for beginning of value",
+		},
 		{
 			name: "Wrong file path",
 			opts: ImportOptions{
@@ -138,3 +157,15 @@ func Test_ValidateImportConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func Test_readConfigFromFile_yaml(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	cs := io.ColorScheme()
+
+	fromJSON, err := readConfigFromFile(cs, "test_artifacts/config_mock.json", "", true)
+	require.NoError(t, err)
+	fromYAML, err := readConfigFromFile(cs, "test_artifacts/config_mock.yaml", "", true)
+	require.NoError(t, err)
+
+	assert.Equal(t, fromJSON, fromYAML)
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/ask"
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+func ValidateRuleFlags(flags shared.RuleFlags) error {
+	if flags.RuleID == "" {
+		return fmt.Errorf("a unique rule id is required")
+	}
+
+	if flags.Anchoring != "" && !utils.Contains(shared.AnchoringValues(), flags.Anchoring) {
+		return fmt.Errorf(
+			"invalid anchoring %q, expected one of: %s",
+			flags.Anchoring,
+			strings.Join(shared.AnchoringValues(), ", "),
+		)
+	}
+
+	if len(flags.Promotions) == 0 && len(flags.Hides) == 0 && flags.Filters == "" && flags.Params == "" {
+		return fmt.Errorf("at least 1 consequence is required (--promote, --hide, --filters or --params)")
+	}
+
+	return nil
+}
+
+type FlagsProvided struct {
+	idProvided, patternProvided, anchoringProvided, contextProvided, promoteProvided, hideProvided, filtersProvided, paramsProvided bool
+}
+
+func AskRule(flags *shared.RuleFlags, cmd *cobra.Command) error {
+	flagsProvided := FlagsProvided{
+		idProvided:        cmd.Flags().Changed("id"),
+		patternProvided:   cmd.Flags().Changed("pattern"),
+		anchoringProvided: cmd.Flags().Changed("anchoring"),
+		contextProvided:   cmd.Flags().Changed("context"),
+		promoteProvided:   cmd.Flags().Changed("promote"),
+		hideProvided:      cmd.Flags().Changed("hide"),
+		filtersProvided:   cmd.Flags().Changed("filters"),
+		paramsProvided:    cmd.Flags().Changed("params"),
+	}
+
+	if !flagsProvided.idProvided {
+		err := ask.AskInputQuestion(
+			"id:",
+			&flags.RuleID,
+			flags.RuleID,
+			survey.WithValidator(survey.Required),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := AskRuleConditionQuestions(flags, flagsProvided)
+	if err != nil {
+		return err
+	}
+
+	// Consequences provided as flags aren't completed with prompts
+	if flagsProvided.promoteProvided || flagsProvided.hideProvided || flagsProvided.filtersProvided ||
+		flagsProvided.paramsProvided {
+		return nil
+	}
+
+	return AskRuleConsequenceQuestions(flags)
+}
+
+func AskRuleConditionQuestions(flags *shared.RuleFlags, flagsProvided FlagsProvided) error {
+	if !flagsProvided.patternProvided {
+		err := ask.AskInputQuestion("pattern (leave empty for no query condition):", &flags.Pattern, flags.Pattern)
+		if err != nil {
+			return err
+		}
+	}
+
+	if flags.Pattern != "" && !flagsProvided.anchoringProvided {
+		defaultAnchoring := flags.Anchoring
+		if defaultAnchoring == "" {
+			defaultAnchoring = "contains"
+		}
+		err := ask.AskSelectQuestion(
+			"anchoring:",
+			&flags.Anchoring,
+			shared.AnchoringValues(),
+			defaultAnchoring,
+			survey.WithValidator(survey.Required),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !flagsProvided.contextProvided {
+		return ask.AskInputQuestion("context (leave empty for no context):", &flags.Context, flags.Context)
+	}
+
+	return nil
+}
+
+func AskRuleConsequenceQuestions(flags *shared.RuleFlags) error {
+	err := ask.AskCommaSeparatedInputQuestion(
+		"promotions (comma separated objectID:position):",
+		&flags.Promotions,
+		flags.Promotions,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = ask.AskCommaSeparatedInputQuestion(
+		"hidden records (comma separated objectIDs):",
+		&flags.Hides,
+		flags.Hides,
+	)
+	if err != nil {
+		return err
+	}
+
+	return ask.AskInputQuestion("filters:", &flags.Filters, flags.Filters)
+}
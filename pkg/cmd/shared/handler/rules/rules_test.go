@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algolia/cli/pkg/cmd/rules/shared"
+)
+
+func Test_ValidateRuleFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		ruleFlags   shared.RuleFlags
+		wantsErrMsg string
+	}{
+		{
+			name:      "Promotion",
+			ruleFlags: shared.RuleFlags{RuleID: "1", Pattern: "star wars", Promotions: []string{"42:0"}},
+		},
+		{
+			name:      "Filters without condition",
+			ruleFlags: shared.RuleFlags{RuleID: "1", Filters: "rating:G"},
+		},
+		{
+			name:        "Without id",
+			ruleFlags:   shared.RuleFlags{Hides: []string{"13"}},
+			wantsErrMsg: "a unique rule id is required",
+		},
+		{
+			name:        "Invalid anchoring",
+			ruleFlags:   shared.RuleFlags{RuleID: "1", Pattern: "kids", Anchoring: "equals", Hides: []string{"13"}},
+			wantsErrMsg: `invalid anchoring "equals", expected one of: is, startsWith, endsWith, contains`,
+		},
+		{
+			name:        "Without consequence",
+			ruleFlags:   shared.RuleFlags{RuleID: "1", Pattern: "kids"},
+			wantsErrMsg: "at least 1 consequence is required (--promote, --hide, --filters or --params)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRuleFlags(tt.ruleFlags)
+			if tt.wantsErrMsg != "" {
+				assert.EqualError(t, err, tt.wantsErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
 	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
@@ -30,7 +31,10 @@ func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
-		PrintFlags:   cmdutil.NewPrintFlags().WithDefaultOutput("json"),
+		PrintFlags: cmdutil.NewPrintFlags().
+			WithDefaultOutput("json").
+			WithYAMLOutput().
+			WithPrinter("solr", &shared.SolrPrinter{}),
 	}
 
 	cmd := &cobra.Command{
@@ -49,6 +53,13 @@ func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 
 			# List all the synonyms in the 'MOVIES' index and save them in the 'synonyms.json' file
 			$ algolia synonyms browse MOVIES > synonyms.json
+
+			# List all the synonyms in the 'MOVIES' index and save them in the 'synonyms.yaml' file
+			$ algolia synonyms browse MOVIES -o yaml > synonyms.yaml
+
+			# List all the synonyms in the 'MOVIES' index and save them in a Solr 'synonyms.txt' file
+			# Placeholders and alternative corrections can't be represented in this format and are skipped
+			$ algolia synonyms browse MOVIES -o solr > synonyms.txt
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/algolia/cli/pkg/httpmock"
@@ -32,6 +33,25 @@ func Test_runBrowseCmd(t *testing.T) {
 			},
 			wantOut: "{\"objectID\":\"foo\",\"type\":\"synonym\"}\n{\"objectID\":\"bar\",\"type\":\"synonym\"}\n",
 		},
+		{
+			name: "multiple synonyms as YAML",
+			cli:  "foo -o yaml",
+			hits: []search.SynonymHit{
+				{ObjectID: "foo", Type: "synonym", Synonyms: []string{"foo", "true"}},
+				{ObjectID: "bar", Type: "synonym"},
+			},
+			wantOut: "---\nobjectID: foo\nsynonyms:\n  - foo\n  - \"true\"\ntype: synonym\n---\nobjectID: bar\ntype: synonym\n",
+		},
+		{
+			name: "multiple synonyms in the Solr format",
+			cli:  "foo -o solr",
+			hits: []search.SynonymHit{
+				{ObjectID: "foo", Type: "synonym", Synonyms: []string{"foo", "bar"}},
+				{ObjectID: "bar", Type: "placeholder"},
+				{ObjectID: "baz", Type: "oneWaySynonym", Input: utils.ToPtr("baz"), Synonyms: []string{"qux"}},
+			},
+			wantOut: "foo, bar\nbaz => qux\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -28,6 +28,8 @@ type DeleteOptions struct {
 	Wait              bool
 
 	DoConfirm bool
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewDeleteCmd creates and returns a delete command for index synonyms
@@ -38,6 +40,7 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
 	cmd := &cobra.Command{
@@ -85,6 +88,8 @@ func NewDeleteCmd(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&confirm, "confirm", "y", false, "Skip confirmation prompt")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "Wait for the operation to complete")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -134,12 +139,10 @@ func runDeleteCmd(opts *DeleteOptions) error {
 		if err != nil {
 			return fmt.Errorf("failed to delete synonym %s: %w", synonymID, err)
 		}
-		if opts.Wait {
-			taskIDs = append(taskIDs, res.TaskID)
-		}
+		taskIDs = append(taskIDs, res.TaskID)
 	}
 
-	if len(taskIDs) > 0 {
+	if opts.Wait {
 		for _, taskID := range taskIDs {
 			_, err := client.WaitForTask(opts.Index, taskID)
 			if err != nil {
@@ -148,6 +151,10 @@ func runDeleteCmd(opts *DeleteOptions) error {
 		}
 	}
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, cmdutil.IndexTasks(opts.Index, taskIDs))
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(
@@ -0,0 +1,124 @@
+package get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type GetOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index      string
+	SynonymIDs []string
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewGetCmd creates and returns a get command for synonyms
+func NewGetCmd(f *cmdutil.Factory, runF func(*GetOptions) error) *cobra.Command {
+	opts := &GetOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "get <index> <synonym-id>...",
+		Args:              validators.AtLeastNArgs(2),
+		ValidArgsFunction: cmdutil.SynonymIDs(opts.SearchClient),
+		Annotations: map[string]string{
+			"runInWebCLI": "true",
+			"acls":        "settings",
+		},
+		Short: "Get synonyms of an index by ID.",
+		Long: heredoc.Doc(`
+			This command prints the synonyms with the given IDs.
+			Use --output json to get the synonyms as JSON objects, one per line.
+		`),
+		Example: heredoc.Doc(`
+			# Get the synonym with the ID "1" of the "MOVIES" index
+			$ algolia synonyms get MOVIES 1
+
+			# Get the synonyms with the IDs "1" and "2" of the "MOVIES" index as JSON
+			$ algolia synonyms get MOVIES 1 2 -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			opts.SynonymIDs = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runGetCmd(opts)
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runGetCmd(opts *GetOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	synonyms := make([]search.SynonymHit, 0, len(opts.SynonymIDs))
+	for _, id := range opts.SynonymIDs {
+		synonym, err := client.GetSynonym(client.NewApiGetSynonymRequest(opts.Index, id))
+		if err != nil {
+			var apiErr *search.APIError
+			if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+				return fmt.Errorf("synonym %s doesn't exist in %s", id, opts.Index)
+			}
+			return fmt.Errorf("failed to get synonym %s: %w", id, err)
+		}
+		synonyms = append(synonyms, *synonym)
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		for _, synonym := range synonyms {
+			if err := p.Print(opts.IO, synonym); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("ID", nil, nil)
+		table.AddField("TYPE", nil, nil)
+		table.AddField("SYNONYMS", nil, nil)
+		table.EndRow()
+	}
+	for _, synonym := range synonyms {
+		table.AddField(synonym.ObjectID, nil, nil)
+		table.AddField(string(synonym.Type), nil, nil)
+		table.AddField(shared.FormatSynonym(synonym), nil, nil)
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -0,0 +1,98 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runGetCmd(t *testing.T) {
+	synonyms := map[string]search.SynonymHit{
+		"1": {
+			ObjectID: "1",
+			Type:     search.SYNONYM_TYPE_SYNONYM,
+			Synonyms: []string{"tv", "television"},
+		},
+		"2": {
+			ObjectID:     "2",
+			Type:         search.SYNONYM_TYPE_PLACEHOLDER,
+			Placeholder:  utils.ToPtr("<street>"),
+			Replacements: []string{"street", "st"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cli      string
+		isTTY    bool
+		synonyms []string
+		missing  bool
+		wantOut  string
+		wantErr  string
+	}{
+		{
+			name:     "TTY",
+			cli:      "MOVIES 1 2",
+			isTTY:    true,
+			synonyms: []string{"1", "2"},
+			wantOut: "ID  TYPE         SYNONYMS\n" +
+				"1   synonym      tv, television\n" +
+				"2   placeholder  <street> => street, st\n",
+		},
+		{
+			name:     "no TTY",
+			cli:      "MOVIES 1",
+			synonyms: []string{"1"},
+			wantOut:  "1\tsynonym\ttv, television\n",
+		},
+		{
+			name:     "JSON output",
+			cli:      "MOVIES 1 2 -o json",
+			synonyms: []string{"1", "2"},
+			wantOut: "{\"objectID\":\"1\",\"synonyms\":[\"tv\",\"television\"],\"type\":\"synonym\"}\n" +
+				"{\"objectID\":\"2\",\"placeholder\":\"\\u003cstreet\\u003e\",\"replacements\":[\"street\",\"st\"],\"type\":\"placeholder\"}\n",
+		},
+		{
+			name:    "missing synonym",
+			cli:     "MOVIES 3",
+			missing: true,
+			wantErr: "synonym 3 doesn't exist in MOVIES",
+		},
+		{
+			name:    "missing synonym ID",
+			cli:     "MOVIES",
+			wantErr: "`get` requires at least 2 arguments.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			for _, id := range tt.synonyms {
+				r.Register(
+					httpmock.REST("GET", "1/indexes/MOVIES/synonyms/"+id),
+					httpmock.JSONResponse(synonyms[id]),
+				)
+			}
+			if tt.missing {
+				r.Register(httpmock.REST("GET", "1/indexes/MOVIES/synonyms/3"), httpmock.ErrorResponse())
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewGetCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/spf13/cobra"
 
+	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
 	"github.com/algolia/cli/pkg/cmdutil"
 	"github.com/algolia/cli/pkg/config"
 	"github.com/algolia/cli/pkg/iostreams"
@@ -26,6 +27,8 @@ type ImportOptions struct {
 	ReplaceExistingSynonyms bool
 	Wait                    bool
 	Scanner                 *bufio.Scanner
+
+	PrintFlags *cmdutil.PrintFlags
 }
 
 // NewImportCmd creates and returns an import command for synonyms
@@ -34,9 +37,10 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		IO:           f.IOStreams,
 		Config:       f.Config,
 		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
 	}
 
-	var file string
+	var file, format string
 
 	cmd := &cobra.Command{
 		Use:               "import <index> -F <file>",
@@ -48,12 +52,24 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		Short: "Import synonyms to the index",
 		Long: heredoc.Doc(`
 			Import synonyms to the provided index.
-			The file must contains one single JSON synonym per line (newline delimited JSON objects - ndjson format: https://ndjson.org/).
+			The file must contains one single JSON synonym per line (newline delimited JSON objects - ndjson format: https://ndjson.org/),
+			or YAML documents with one synonym or a list of synonyms each (for files with a .yaml or .yml extension, or with --format yaml).
+
+			With --format solr, the file is a Solr or Elasticsearch synonyms file:
+			equivalent synonyms (a, b, c) are imported as regular synonyms,
+			and explicit mappings (a, b => c, d) as one-way synonyms, one for each term on the left side.
+			The objectIDs are generated from the synonyms, so importing the same file again updates them.
 		`),
 		Example: heredoc.Doc(`
 			# Import synonyms from the "synonyms.ndjson" file to the "MOVIES" index
 			$ algolia synonyms import MOVIES -F synonyms.ndjson
 
+			# Import synonyms from the "synonyms.yaml" file to the "MOVIES" index
+			$ algolia synonyms import MOVIES -F synonyms.yaml
+
+			# Import synonyms from the Solr "synonyms.txt" file to the "MOVIES" index
+			$ algolia synonyms import MOVIES -F synonyms.txt --format solr
+
 			# Import synonyms from the standard input to the "MOVIES" index
 			$ cat synonyms.ndjson | algolia synonyms import MOVIES -F -
 
@@ -69,11 +85,21 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Index = args[0]
 
-			scanner, err := cmdutil.ScanFile(file, opts.IO.In)
+			fileFormat, err := cmdutil.FileFormat(format, file, "ndjson", "yaml", "solr")
+			if err != nil {
+				return err
+			}
+			switch fileFormat {
+			case "yaml":
+				opts.Scanner, err = cmdutil.ScanYAMLFile(file, opts.IO.In)
+			case "solr":
+				opts.Scanner, err = shared.ScanSolrFile(file, opts.IO.In)
+			default:
+				opts.Scanner, err = cmdutil.ScanFile(file, opts.IO.In)
+			}
 			if err != nil {
 				return err
 			}
-			opts.Scanner = scanner
 
 			if runF != nil {
 				return runF(opts)
@@ -86,6 +112,13 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 	cmd.Flags().
 		StringVarP(&file, "file", "F", "", "Import synonyms from a `file` (use \"-\" to read from standard input)")
 	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().
+		StringVar(&format, "format", "", "Format of the file: ndjson, yaml or solr (default: detected from the file extension, else ndjson)")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"ndjson": "one JSON synonym per line",
+		"yaml":   "YAML documents or lists of synonyms",
+		"solr":   "Solr or Elasticsearch synonyms file",
+	}))
 
 	cmd.Flags().
 		BoolVarP(&opts.ForwardToReplicas, "forward-to-replicas", "f", true, "Whether to also add the synonyms to replicas")
@@ -93,6 +126,8 @@ func NewImportCmd(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Co
 		BoolVarP(&opts.ReplaceExistingSynonyms, "replace-existing-synonyms", "r", false, "Replace existing synonyms in the index")
 	cmd.Flags().BoolVarP(&opts.Wait, "wait", "w", false, "wait for the operation to complete")
 
+	opts.PrintFlags.AddFlags(cmd)
+
 	return cmd
 }
 
@@ -149,9 +184,7 @@ func runImportCmd(opts *ImportOptions) error {
 				opts.IO.StopProgressIndicator()
 				return err
 			}
-			if opts.Wait {
-				taskIDs = append(taskIDs, res.TaskID)
-			}
+			taskIDs = append(taskIDs, res.TaskID)
 			synonyms = make([]search.SynonymHit, 0, batchSize)
 			totalCount += count
 			opts.IO.UpdateProgressIndicatorLabel(fmt.Sprintf("Imported %d synonyms", totalCount))
@@ -170,9 +203,7 @@ func runImportCmd(opts *ImportOptions) error {
 			opts.IO.StopProgressIndicator()
 			return err
 		}
-		if opts.Wait {
-			taskIDs = append(taskIDs, res.TaskID)
-		}
+		taskIDs = append(taskIDs, res.TaskID)
 	}
 
 	if totalCount == 0 && opts.ReplaceExistingSynonyms {
@@ -184,12 +215,10 @@ func runImportCmd(opts *ImportOptions) error {
 			opts.IO.StopProgressIndicator()
 			return err
 		}
-		if opts.Wait {
-			taskIDs = append(taskIDs, res.TaskID)
-		}
+		taskIDs = append(taskIDs, res.TaskID)
 	}
 
-	if len(taskIDs) > 0 {
+	if opts.Wait {
 		for _, taskID := range taskIDs {
 			_, err := client.WaitForTask(opts.Index, taskID)
 			if err != nil {
@@ -205,6 +234,10 @@ func runImportCmd(opts *ImportOptions) error {
 		return err
 	}
 
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		return cmdutil.PrintTasks(opts.IO, opts.PrintFlags, cmdutil.IndexTasks(opts.Index, taskIDs))
+	}
+
 	cs := opts.IO.ColorScheme()
 	if opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(
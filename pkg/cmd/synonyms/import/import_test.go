@@ -1,7 +1,9 @@
 package importsynonyms
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -117,6 +119,14 @@ func Test_runExportCmd(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	yamlFile := filepath.Join(t.TempDir(), "synonyms.yml")
+	err = os.WriteFile(
+		yamlFile,
+		[]byte("- objectID: test\n  type: synonym\n  synonyms:\n    - test\n    - \"true\"\n"),
+		0o600,
+	)
+	require.NoError(t, err)
+
 	var largeBatchBuilder strings.Builder
 	for i := 0; i < 1001; i += 1 {
 		largeBatchBuilder.Write(
@@ -155,6 +165,60 @@ func Test_runExportCmd(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:    "from YAML file",
+			cli:     fmt.Sprintf("foo -F '%s'", yamlFile),
+			wantOut: "✓ Successfully imported 1 synonyms to foo\n",
+			setup: func(r *httpmock.Registry) {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/foo/synonyms/batch"),
+					func(req *http.Request) (*http.Response, error) {
+						body, err := io.ReadAll(req.Body)
+						require.NoError(t, err)
+						assert.JSONEq(
+							t,
+							`[{"objectID":"test","type":"synonym","synonyms":["test","true"]}]`,
+							string(body),
+						)
+						return httpmock.JSONResponse(search.UpdatedAtResponse{})(req)
+					},
+				)
+			},
+		},
+		{
+			name:    "from stdin in the Solr format",
+			cli:     "foo -F - --format solr",
+			stdin:   "# TV\ntv, television\ni-pod, i pod => ipod\n",
+			wantOut: "✓ Successfully imported 3 synonyms to foo\n",
+			setup: func(r *httpmock.Registry) {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/foo/synonyms/batch"),
+					func(req *http.Request) (*http.Response, error) {
+						var synonyms []search.SynonymHit
+						require.NoError(t, json.NewDecoder(req.Body).Decode(&synonyms))
+						require.Len(t, synonyms, 3)
+						assert.Equal(t, search.SYNONYM_TYPE_SYNONYM, synonyms[0].Type)
+						assert.Equal(t, []string{"tv", "television"}, synonyms[0].Synonyms)
+						assert.Equal(t, search.SYNONYM_TYPE_ONE_WAY_SYNONYM, synonyms[1].Type)
+						assert.Equal(t, "i-pod", synonyms[1].GetInput())
+						assert.Equal(t, "i pod", synonyms[2].GetInput())
+						return httpmock.JSONResponse(search.UpdatedAtResponse{})(req)
+					},
+				)
+			},
+		},
+		{
+			name:    "from stdin with invalid Solr synonyms",
+			cli:     "foo -F - --format solr",
+			stdin:   "tv, television\ntv\n",
+			wantErr: "failed to parse Solr synonyms: line 2: at least 2 synonyms are required",
+		},
+		{
+			name:    "from stdin with invalid YAML",
+			cli:     "foo -F - --format yaml",
+			stdin:   "objectID: [test",
+			wantErr: "failed to parse YAML file: yaml: line 1: did not find expected ',' or ']'",
+		},
 		{
 			name:    "from stdin with invalid JSON",
 			cli:     "foo -F -",
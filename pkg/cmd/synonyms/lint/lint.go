@@ -0,0 +1,253 @@
+package lint
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/internal/lint"
+	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+type LintOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index   string
+	File    string
+	Format  string
+	Scanner *bufio.Scanner
+	FailOn  lint.Severity
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewLintCmd creates and returns a lint command for index synonyms
+func NewLintCmd(f *cmdutil.Factory, runF func(*LintOptions) error) *cobra.Command {
+	opts := &LintOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var failOn string
+
+	cmd := &cobra.Command{
+		Use:               "lint [<index>] [-F <file>]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"acls": "browse,settings",
+		},
+		Short: "Find problems in the synonyms of an index.",
+		Long: heredoc.Doc(`
+			This command checks the synonyms of an index, or of a file, and reports:
+
+			- error: synonyms with the same words as another synonym
+			- warning: one-way synonyms that form a cycle (a => b and b => a), where a regular synonym would do
+			- warning: words in several regular synonyms: synonyms aren't transitive, so these words get different alternatives
+			- warning: alternative corrections that are also synonyms of the word
+			- warning: placeholders that no record of the index contains
+
+			The file can have one JSON synonym per line, YAML documents, or be a Solr synonyms file (see --format).
+			Placeholders are only checked against an index: with a file, give the index to check against as an argument.
+			The command exits with a non-zero status if it finds issues at least as severe as --fail-on.
+		`),
+		Example: heredoc.Doc(`
+			# Lint the synonyms of the "MOVIES" index
+			$ algolia synonyms lint MOVIES
+
+			# Lint the synonyms of the "synonyms.ndjson" file, without checking them against an index
+			$ algolia synonyms lint -F synonyms.ndjson
+
+			# Lint the Solr "synonyms.txt" file before importing it to the "MOVIES" index, and fail on warnings
+			$ algolia synonyms lint MOVIES -F synonyms.txt --format solr --fail-on warning
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && opts.File == "" {
+				return cmdutil.FlagErrorf("an index or a file (-F) is required")
+			}
+			if len(args) > 0 {
+				opts.Index = args[0]
+			}
+
+			severity, ok := lint.ParseSeverity(failOn)
+			if !ok {
+				return cmdutil.FlagErrorf("invalid --fail-on %q, expected one of: error, warning, info", failOn)
+			}
+			opts.FailOn = severity
+
+			if opts.File != "" {
+				fileFormat, err := cmdutil.FileFormat(opts.Format, opts.File, "ndjson", "yaml", "solr")
+				if err != nil {
+					return err
+				}
+				switch fileFormat {
+				case "yaml":
+					opts.Scanner, err = cmdutil.ScanYAMLFile(opts.File, opts.IO.In)
+				case "solr":
+					opts.Scanner, err = shared.ScanSolrFile(opts.File, opts.IO.In)
+				default:
+					opts.Scanner, err = cmdutil.ScanFile(opts.File, opts.IO.In)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runLintCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		StringVarP(&opts.File, "file", "F", "", "Lint the synonyms of a `file` (use \"-\" to read from standard input)")
+	cmd.Flags().
+		StringVar(&opts.Format, "format", "", "Format of the file: ndjson, yaml or solr (default: detected from the file extension, else ndjson)")
+	_ = cmd.RegisterFlagCompletionFunc("format", cmdutil.StringCompletionFunc(map[string]string{
+		"ndjson": "one JSON synonym per line",
+		"yaml":   "YAML documents or lists of synonyms",
+		"solr":   "Solr or Elasticsearch synonyms file",
+	}))
+	cmd.Flags().
+		StringVar(&failOn, "fail-on", string(lint.Error), "Exit with a non-zero status on issues of this severity or above: error, warning or info")
+	_ = cmd.RegisterFlagCompletionFunc("fail-on", cmdutil.StringCompletionFunc(map[string]string{
+		string(lint.Error):   "Fail on errors only",
+		string(lint.Warning): "Fail on warnings and errors",
+		string(lint.Info):    "Fail on any issue",
+	}))
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runLintCmd(opts *LintOptions) error {
+	var client *search.APIClient
+	if opts.Index != "" {
+		var err error
+		client, err = opts.SearchClient()
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Fetching synonyms")
+	synonyms, err := readSynonyms(client, opts)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	lintOpts := lint.SynonymsOptions{}
+	if client != nil {
+		if placeholders := placeholderTokens(synonyms); len(placeholders) > 0 {
+			opts.IO.UpdateProgressIndicatorLabel("Browsing records")
+			lintOpts.Placeholders, err = browsePlaceholders(client, opts.Index, placeholders)
+			if err != nil {
+				opts.IO.StopProgressIndicator()
+				return err
+			}
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	issues := lint.Synonyms(synonyms, lintOpts)
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		if err := p.Print(opts.IO, issues); err != nil {
+			return err
+		}
+	} else if err := lint.PrintIssues(opts.IO, issues, len(synonyms), "synonym"); err != nil {
+		return err
+	}
+
+	if lint.HasIssues(issues, opts.FailOn) {
+		return cmdutil.ErrSilent
+	}
+	return nil
+}
+
+// readSynonyms reads the synonyms from the file, or browses the synonyms of the index.
+func readSynonyms(client *search.APIClient, opts *LintOptions) ([]search.SynonymHit, error) {
+	var synonyms []search.SynonymHit
+
+	if opts.Scanner != nil {
+		line := 0
+		for opts.Scanner.Scan() {
+			line++
+			text := opts.Scanner.Text()
+			if text == "" {
+				continue
+			}
+			var synonym search.SynonymHit
+			if err := json.Unmarshal([]byte(text), &synonym); err != nil {
+				return nil, fmt.Errorf("failed to parse JSON synonym on line %d: %s", line, err)
+			}
+			synonyms = append(synonyms, synonym)
+		}
+		return synonyms, opts.Scanner.Err()
+	}
+
+	err := client.BrowseSynonyms(
+		opts.Index,
+		*search.NewEmptySearchSynonymsParams(),
+		search.WithAggregator(func(res any, _ error) {
+			if res == nil {
+				return
+			}
+			synonyms = append(synonyms, res.(*search.SearchSynonymsResponse).Hits...)
+		}),
+	)
+	return synonyms, err
+}
+
+// placeholderTokens returns the placeholders of the synonyms, like "<street>".
+func placeholderTokens(synonyms []search.SynonymHit) []string {
+	var placeholders []string
+	for _, synonym := range synonyms {
+		if synonym.Type == search.SYNONYM_TYPE_PLACEHOLDER && synonym.GetPlaceholder() != "" {
+			placeholders = append(placeholders, synonym.GetPlaceholder())
+		}
+	}
+	return placeholders
+}
+
+// browsePlaceholders returns the placeholders found in the records of the index.
+func browsePlaceholders(
+	client *search.APIClient,
+	index string,
+	placeholders []string,
+) (map[string]bool, error) {
+	found := map[string]bool{}
+	err := client.BrowseObjects(
+		index,
+		*search.NewEmptyBrowseParamsObject(),
+		search.WithAggregator(func(res any, err error) {
+			if err != nil || res == nil {
+				return
+			}
+			for _, hit := range res.(*search.BrowseResponse).Hits {
+				lint.PlaceholdersIn(hit.AdditionalProperties, placeholders, found)
+			}
+		}),
+	)
+	return found, err
+}
@@ -0,0 +1,95 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+const synonymsFile = `{"objectID":"1","type":"synonym","synonyms":["tv","television"]}
+{"objectID":"2","type":"synonym","synonyms":["tv","screen"]}
+`
+
+func Test_runLintCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stdin   string
+		isTTY   bool
+		index   bool
+		wantOut string
+		wantErr error
+	}{
+		{
+			name:  "index",
+			cli:   "MOVIES",
+			isTTY: true,
+			index: true,
+			wantOut: "SEVERITY  SYNONYM  CHECK               MESSAGE\n" +
+				"error     2        duplicate-synonym   same synonyms as 1\n" +
+				"warning   3        unused-placeholder  no record contains the placeholder <av...\n" +
+				"\nFound 2 issues in 4 synonyms\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name:    "file without index, warnings don't fail",
+			cli:     "-F -",
+			stdin:   synonymsFile,
+			wantOut: "warning\t1\tconflicting-groups\twords in other synonyms: tv (2)\nwarning\t2\tconflicting-groups\twords in other synonyms: tv (1)\n",
+		},
+		{
+			name:    "solr file, fail on warnings",
+			cli:     "-F - --format solr --fail-on warning -o json",
+			stdin:   "a => b\nb => a\n",
+			wantOut: "[{\"severity\":\"warning\",\"objectID\":\"solr-549ddb7316f2\",\"check\":\"one-way-cycle\",\"message\":\"one-way synonyms form a cycle between: a, b\"},{\"severity\":\"warning\",\"objectID\":\"solr-b0118e069432\",\"check\":\"one-way-cycle\",\"message\":\"one-way synonyms form a cycle between: a, b\"}]\n",
+			wantErr: cmdutil.ErrSilent,
+		},
+		{
+			name:    "no issues",
+			cli:     "-F - --fail-on info",
+			stdin:   `{"objectID":"1","type":"synonym","synonyms":["tv","television"]}`,
+			isTTY:   true,
+			wantOut: "✓ No issues found in 1 synonym\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.index {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/synonyms/search"),
+					httpmock.StringResponse(`{"hits":[
+						{"objectID":"1","type":"synonym","synonyms":["tv","television"]},
+						{"objectID":"2","type":"synonym","synonyms":["television","TV"]},
+						{"objectID":"3","type":"placeholder","placeholder":"<avenue>","replacements":["avenue","av"]},
+						{"objectID":"4","type":"placeholder","placeholder":"<street>","replacements":["street","st"]}
+					],"nbHits":4}`),
+				)
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/browse"),
+					httpmock.StringResponse(`{"hits":[{"objectID":"1","address":"12 <street> Main"}],"page":0,"nbHits":1,"nbPages":1,"hitsPerPage":1000,"processingTimeMS":1,"exhaustiveNbHits":true,"query":"","params":""}`),
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, tt.stdin)
+			cmd := NewLintCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			assert.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func Test_NewLintCmd_missingInput(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewLintCmd(f, nil)
+	_, err := test.Execute(cmd, "", out)
+	assert.EqualError(t, err, "an index or a file (-F) is required")
+}
@@ -0,0 +1,176 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	algoliaSearch "github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/synonyms/shared"
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/printers"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+type SearchOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*algoliaSearch.APIClient, error)
+
+	Index  string
+	Params *algoliaSearch.SearchSynonymsParams
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// NewSearchCmd creates and returns a search command for synonyms
+func NewSearchCmd(f *cmdutil.Factory, runF func(*SearchOptions) error) *cobra.Command {
+	opts := &SearchOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	var (
+		query, synonymType string
+		page, hitsPerPage  int32
+	)
+
+	cmd := &cobra.Command{
+		Use:               "search <index> [--query <query>] [--type <type>]",
+		Args:              validators.ExactArgs(1),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Annotations: map[string]string{
+			"runInWebCLI": "true",
+			"acls":        "settings",
+		},
+		Short: "Search the synonyms of an index.",
+		Long: heredoc.Doc(`
+			This command searches the synonyms of the specified index.
+			Results are paginated: use --page and --hits-per-page to go through them.
+		`),
+		Example: heredoc.Doc(`
+			# Search the synonyms of the "MOVIES" index matching "tv"
+			$ algolia synonyms search MOVIES --query tv
+
+			# Search the one-way synonyms of the "MOVIES" index
+			$ algolia synonyms search MOVIES --type onewaysynonym
+
+			# Get the second page of the placeholders, as JSON
+			$ algolia synonyms search MOVIES --type placeholder --page 1 -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+
+			if page < 0 {
+				return cmdutil.FlagErrorf("--page must be a positive integer")
+			}
+			if hitsPerPage < 1 || hitsPerPage > 1000 {
+				return cmdutil.FlagErrorf("--hits-per-page must be between 1 and 1000")
+			}
+
+			params := algoliaSearch.NewEmptySearchSynonymsParams().
+				SetQuery(query).
+				SetPage(page).
+				SetHitsPerPage(hitsPerPage)
+			if synonymType != "" {
+				// The API only accepts lowercase types to filter the search
+				t := strings.ToLower(synonymType)
+				if !utils.Contains(shared.SearchTypeValues(), t) {
+					return cmdutil.FlagErrorf(
+						"invalid type %q, expected one of: %s",
+						synonymType,
+						strings.Join(shared.SearchTypeValues(), ", "),
+					)
+				}
+				params.SetType(algoliaSearch.SynonymType(t))
+			}
+			opts.Params = params
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSearchCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&query, "query", "q", "", "Search query for the synonyms")
+	cmd.Flags().
+		StringVarP(&synonymType, "type", "t", "", "Only return synonyms of this type: synonym, onewaysynonym, altcorrection1, altcorrection2 or placeholder")
+	_ = cmd.RegisterFlagCompletionFunc("type", cmdutil.StringCompletionFunc(map[string]string{
+		"synonym":        "Regular synonyms",
+		"onewaysynonym":  "One-way synonyms",
+		"altcorrection1": "Alternative corrections with 1 typo",
+		"altcorrection2": "Alternative corrections with 2 typos",
+		"placeholder":    "Placeholders",
+	}))
+	cmd.Flags().Int32Var(&page, "page", 0, "Page of results to return (starting at 0)")
+	cmd.Flags().Int32Var(&hitsPerPage, "hits-per-page", 20, "Number of synonyms per page")
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runSearchCmd(opts *SearchOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel("Searching synonyms")
+	res, err := client.SearchSynonyms(
+		client.NewApiSearchSynonymsRequest(opts.Index).WithSearchSynonymsParams(opts.Params),
+	)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, res)
+	}
+
+	table := printers.NewTablePrinter(opts.IO)
+	if table.IsTTY() {
+		table.AddField("ID", nil, nil)
+		table.AddField("TYPE", nil, nil)
+		table.AddField("SYNONYMS", nil, nil)
+		table.EndRow()
+	}
+	for _, synonym := range res.Hits {
+		table.AddField(synonym.ObjectID, nil, nil)
+		table.AddField(string(synonym.Type), nil, nil)
+		table.AddField(shared.FormatSynonym(synonym), nil, nil)
+		table.EndRow()
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		hitsPerPage := opts.Params.GetHitsPerPage()
+		nbPages := (res.NbHits + hitsPerPage - 1) / hitsPerPage
+		fmt.Fprintf(
+			opts.IO.Out,
+			"Page %d of %d (%s)\n",
+			opts.Params.GetPage()+1,
+			max(nbPages, 1),
+			utils.Pluralize(int(res.NbHits), "synonym"),
+		)
+	}
+
+	return nil
+}
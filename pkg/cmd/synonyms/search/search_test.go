@@ -0,0 +1,104 @@
+package search
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	algoliaSearch "github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runSearchCmd(t *testing.T) {
+	res := algoliaSearch.SearchSynonymsResponse{
+		Hits: []algoliaSearch.SynonymHit{
+			{
+				ObjectID: "1",
+				Type:     algoliaSearch.SYNONYM_TYPE_SYNONYM,
+				Synonyms: []string{"tv", "television"},
+			},
+			{
+				ObjectID: "2",
+				Type:     algoliaSearch.SYNONYM_TYPE_ONEWAYSYNONYM,
+				Input:    utils.ToPtr("tv"),
+				Synonyms: []string{"screen"},
+			},
+		},
+		NbHits: 22,
+	}
+
+	tests := []struct {
+		name       string
+		cli        string
+		isTTY      bool
+		wantParams string
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:       "TTY",
+			cli:        "MOVIES --query tv --page 1",
+			isTTY:      true,
+			wantParams: `{"query":"tv","page":1,"hitsPerPage":20}`,
+			wantOut: "ID  TYPE           SYNONYMS\n" +
+				"1   synonym        tv, television\n" +
+				"2   onewaysynonym  tv => screen\n" +
+				"Page 2 of 2 (22 synonyms)\n",
+		},
+		{
+			name:       "no TTY with type",
+			cli:        "MOVIES --type oneWaySynonym --hits-per-page 2",
+			wantParams: `{"query":"","type":"onewaysynonym","page":0,"hitsPerPage":2}`,
+			wantOut:    "1\tsynonym\ttv, television\n2\tonewaysynonym\ttv => screen\n",
+		},
+		{
+			name:       "JSON output",
+			cli:        "MOVIES -o json",
+			wantParams: `{"query":"","page":0,"hitsPerPage":20}`,
+			wantOut:    "{\"hits\":[{\"objectID\":\"1\",\"synonyms\":[\"tv\",\"television\"],\"type\":\"synonym\"},{\"input\":\"tv\",\"objectID\":\"2\",\"synonyms\":[\"screen\"],\"type\":\"onewaysynonym\"}],\"nbHits\":22}\n",
+		},
+		{
+			name:    "invalid type",
+			cli:     "MOVIES --type regular",
+			wantErr: `invalid type "regular", expected one of: synonym, onewaysynonym, altcorrection1, altcorrection2, placeholder`,
+		},
+		{
+			name:    "invalid page",
+			cli:     "MOVIES --page -1",
+			wantErr: "--page must be a positive integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			if tt.wantErr == "" {
+				r.Register(
+					httpmock.REST("POST", "1/indexes/MOVIES/synonyms/search"),
+					func(req *http.Request) (*http.Response, error) {
+						body, err := io.ReadAll(req.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, tt.wantParams, string(body))
+						return httpmock.JSONResponse(res)(req)
+					},
+				)
+			}
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewSearchCmd(f, nil)
+			_, err := test.Execute(cmd, tt.cli, out)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
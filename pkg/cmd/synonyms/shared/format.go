@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+)
+
+// SearchTypeValues are the synonym types accepted to filter a search of synonyms.
+func SearchTypeValues() []string {
+	return []string{
+		string(search.SYNONYM_TYPE_SYNONYM),
+		string(search.SYNONYM_TYPE_ONEWAYSYNONYM),
+		string(search.SYNONYM_TYPE_ALTCORRECTION1),
+		string(search.SYNONYM_TYPE_ALTCORRECTION2),
+		string(search.SYNONYM_TYPE_PLACEHOLDER),
+	}
+}
+
+// FormatSynonym returns a short description of a synonym, like `tv, television` for a regular synonym,
+// or `ipod => iphone, ipad` for a one-way synonym.
+func FormatSynonym(synonym search.SynonymHit) string {
+	switch synonym.Type {
+	case search.SYNONYM_TYPE_ONE_WAY_SYNONYM, search.SYNONYM_TYPE_ONEWAYSYNONYM:
+		return fmt.Sprintf("%s => %s", synonym.GetInput(), strings.Join(synonym.Synonyms, ", "))
+	case search.SYNONYM_TYPE_PLACEHOLDER:
+		return fmt.Sprintf("%s => %s", synonym.GetPlaceholder(), strings.Join(synonym.Replacements, ", "))
+	case search.SYNONYM_TYPE_ALT_CORRECTION1,
+		search.SYNONYM_TYPE_ALTCORRECTION1,
+		search.SYNONYM_TYPE_ALT_CORRECTION2,
+		search.SYNONYM_TYPE_ALTCORRECTION2:
+		return fmt.Sprintf("%s => %s", synonym.GetWord(), strings.Join(synonym.Corrections, ", "))
+	default:
+		return strings.Join(synonym.Synonyms, ", ")
+	}
+}
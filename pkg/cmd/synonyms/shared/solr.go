@@ -0,0 +1,208 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+// SolrObjectIDPrefix is the prefix of the objectIDs of the synonyms imported from a Solr file.
+const SolrObjectIDPrefix = "solr-"
+
+// ParseSolrSynonyms parses a Solr (or Elasticsearch) synonyms file.
+//
+// Equivalent synonyms (`a, b, c`) become regular synonyms, and explicit mappings (`a, b => c, d`)
+// become one one-way synonym per term on the left side.
+// The objectIDs are generated from the content of the synonyms, so importing the same file twice
+// updates the synonyms instead of duplicating them.
+func ParseSolrSynonyms(r io.Reader) ([]search.SynonymHit, error) {
+	var synonyms []search.SynonymHit
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		parts := splitSolr(text, "=>")
+		switch len(parts) {
+		case 1:
+			terms, err := solrTerms(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			if len(terms) < 2 {
+				return nil, fmt.Errorf("line %d: at least 2 synonyms are required", line)
+			}
+			synonyms = append(synonyms, *search.NewEmptySynonymHit().
+				SetObjectID(solrObjectID(Regular, "", terms)).
+				SetType(search.SYNONYM_TYPE_SYNONYM).
+				SetSynonyms(terms))
+		case 2:
+			inputs, err := solrTerms(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			terms, err := solrTerms(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			for _, input := range inputs {
+				synonyms = append(synonyms, *search.NewEmptySynonymHit().
+					SetObjectID(solrObjectID(OneWay, input, terms)).
+					SetType(search.SYNONYM_TYPE_ONE_WAY_SYNONYM).
+					SetInput(input).
+					SetSynonyms(terms))
+			}
+		default:
+			return nil, fmt.Errorf("line %d: only one `=>` is allowed", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return synonyms, nil
+}
+
+// ScanSolrFile reads a Solr synonyms file and returns a scanner over the synonyms, one JSON synonym per line.
+func ScanSolrFile(filename string, stdin io.ReadCloser) (*bufio.Scanner, error) {
+	b, err := cmdutil.ReadFile(filename, stdin)
+	if err != nil {
+		return nil, err
+	}
+	synonyms, err := ParseSolrSynonyms(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Solr synonyms: %w", err)
+	}
+
+	items := make([]json.RawMessage, 0, len(synonyms))
+	for _, synonym := range synonyms {
+		b, err := json.Marshal(synonym)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, b)
+	}
+	return cmdutil.NewNDJSONScanner(items), nil
+}
+
+// FormatSolrSynonym formats a synonym as a line of a Solr synonyms file.
+// Only regular and one-way synonyms can be represented in this format.
+func FormatSolrSynonym(synonym search.SynonymHit) (string, error) {
+	switch synonym.Type {
+	case search.SYNONYM_TYPE_SYNONYM:
+		return joinSolr(synonym.Synonyms), nil
+	case search.SYNONYM_TYPE_ONE_WAY_SYNONYM, search.SYNONYM_TYPE_ONEWAYSYNONYM:
+		return fmt.Sprintf(
+			"%s => %s",
+			joinSolr([]string{synonym.GetInput()}),
+			joinSolr(synonym.Synonyms),
+		), nil
+	default:
+		return "", fmt.Errorf(
+			"synonym %q of type %s can't be represented in the Solr format",
+			synonym.ObjectID,
+			synonym.Type,
+		)
+	}
+}
+
+// splitSolr splits a string on a separator, except where the separator is escaped with a backslash.
+func splitSolr(s string, sep string) []string {
+	var parts []string
+	current := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			current.WriteByte(s[i])
+			current.WriteByte(s[i+1])
+			i++
+		case strings.HasPrefix(s[i:], sep):
+			parts = append(parts, current.String())
+			current.Reset()
+			i += len(sep) - 1
+		default:
+			current.WriteByte(s[i])
+		}
+	}
+	return append(parts, current.String())
+}
+
+// solrTerms parses a comma-separated list of terms.
+func solrTerms(s string) ([]string, error) {
+	var terms []string
+	for _, part := range splitSolr(s, ",") {
+		term := unescapeSolr(strings.Join(strings.Fields(part), " "))
+		if term == "" {
+			return nil, fmt.Errorf("empty synonym in %q", strings.TrimSpace(s))
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+func unescapeSolr(s string) string {
+	b := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func joinSolr(terms []string) string {
+	escaped := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = strings.ReplaceAll(t, `\`, `\\`)
+		t = strings.ReplaceAll(t, ",", `\,`)
+		t = strings.ReplaceAll(t, "=>", `\=>`)
+		escaped = append(escaped, t)
+	}
+	return strings.Join(escaped, ", ")
+}
+
+// solrObjectID generates an objectID from the content of a synonym.
+// The terms are sorted, so that reordering them keeps the same objectID.
+func solrObjectID(synonymType string, input string, terms []string) string {
+	sorted := make([]string, len(terms))
+	copy(sorted, terms)
+	sort.Strings(sorted)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s", synonymType, input, strings.Join(sorted, "\x00"))
+	return SolrObjectIDPrefix + hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// SolrPrinter prints synonyms as the lines of a Solr synonyms file.
+// The synonyms that can't be represented in this format are skipped with a warning.
+type SolrPrinter struct{}
+
+func (p *SolrPrinter) Print(ios *iostreams.IOStreams, data interface{}) error {
+	synonym, ok := data.(search.SynonymHit)
+	if !ok {
+		return fmt.Errorf("the solr format can only print synonyms")
+	}
+
+	line, err := FormatSolrSynonym(synonym)
+	if err != nil {
+		fmt.Fprintf(ios.ErrOut, "%s Skipped: %s\n", ios.ColorScheme().WarningIcon(), err)
+		return nil
+	}
+	_, err = fmt.Fprintln(ios.Out, line)
+	return err
+}
@@ -0,0 +1,141 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+func Test_ParseSolrSynonyms(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		want    []search.SynonymHit
+		wantErr string
+	}{
+		{
+			name: "equivalent synonyms",
+			file: "# comment\n\ntv,  television ,  tele vision\n",
+			want: []search.SynonymHit{
+				{
+					Type:     search.SYNONYM_TYPE_SYNONYM,
+					Synonyms: []string{"tv", "television", "tele vision"},
+				},
+			},
+		},
+		{
+			name: "explicit mappings",
+			file: "i-pod, i pod => ipod, iphone",
+			want: []search.SynonymHit{
+				{
+					Type:     search.SYNONYM_TYPE_ONE_WAY_SYNONYM,
+					Input:    utils.ToPtr("i-pod"),
+					Synonyms: []string{"ipod", "iphone"},
+				},
+				{
+					Type:     search.SYNONYM_TYPE_ONE_WAY_SYNONYM,
+					Input:    utils.ToPtr("i pod"),
+					Synonyms: []string{"ipod", "iphone"},
+				},
+			},
+		},
+		{
+			name: "escaped separators",
+			file: `a\,b, c\=>d`,
+			want: []search.SynonymHit{
+				{
+					Type:     search.SYNONYM_TYPE_SYNONYM,
+					Synonyms: []string{"a,b", "c=>d"},
+				},
+			},
+		},
+		{
+			name:    "single synonym",
+			file:    "tv\n",
+			wantErr: "line 1: at least 2 synonyms are required",
+		},
+		{
+			name:    "empty synonym",
+			file:    "tv, television\ntv, , tele\n",
+			wantErr: `line 2: empty synonym in "tv, , tele"`,
+		},
+		{
+			name:    "several mappings",
+			file:    "a => b => c",
+			wantErr: "line 1: only one `=>` is allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			synonyms, err := ParseSolrSynonyms(strings.NewReader(tt.file))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, synonyms, len(tt.want))
+
+			for i, synonym := range synonyms {
+				assert.True(t, strings.HasPrefix(synonym.ObjectID, SolrObjectIDPrefix))
+				synonym.ObjectID = ""
+				assert.Equal(t, tt.want[i], synonym)
+			}
+		})
+	}
+}
+
+func Test_ParseSolrSynonyms_stableObjectIDs(t *testing.T) {
+	first, err := ParseSolrSynonyms(strings.NewReader("tv, television\na => b"))
+	require.NoError(t, err)
+	second, err := ParseSolrSynonyms(strings.NewReader("# reordered\ntelevision, tv\n\na => b\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, first[0].ObjectID, second[0].ObjectID)
+	assert.Equal(t, first[1].ObjectID, second[1].ObjectID)
+	assert.NotEqual(t, first[0].ObjectID, first[1].ObjectID)
+}
+
+func Test_SolrPrinter(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	p := &SolrPrinter{}
+
+	synonyms := []search.SynonymHit{
+		*search.NewEmptySynonymHit().
+			SetObjectID("1").
+			SetType(search.SYNONYM_TYPE_SYNONYM).
+			SetSynonyms([]string{"tv", "a,b"}),
+		*search.NewEmptySynonymHit().
+			SetObjectID("2").
+			SetType(search.SYNONYM_TYPE_ONEWAYSYNONYM).
+			SetInput("ipod").
+			SetSynonyms([]string{"iphone"}),
+		*search.NewEmptySynonymHit().
+			SetObjectID("3").
+			SetType(search.SYNONYM_TYPE_PLACEHOLDER).
+			SetPlaceholder("<street>").
+			SetReplacements([]string{"street", "st"}),
+	}
+	for _, synonym := range synonyms {
+		require.NoError(t, p.Print(io, synonym))
+	}
+
+	assert.Equal(t, "tv, a\\,b\nipod => iphone\n", stdout.String())
+	assert.Equal(
+		t,
+		"! Skipped: synonym \"3\" of type placeholder can't be represented in the Solr format\n",
+		stderr.String(),
+	)
+
+	// Round trip
+	parsed, err := ParseSolrSynonyms(strings.NewReader(stdout.String()))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tv", "a,b"}, parsed[0].Synonyms)
+	assert.Equal(t, "ipod", parsed[1].GetInput())
+}
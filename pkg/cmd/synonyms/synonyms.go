@@ -5,8 +5,11 @@ import (
 
 	"github.com/algolia/cli/pkg/cmd/synonyms/browse"
 	"github.com/algolia/cli/pkg/cmd/synonyms/delete"
+	"github.com/algolia/cli/pkg/cmd/synonyms/get"
 	importSynonyms "github.com/algolia/cli/pkg/cmd/synonyms/import"
+	"github.com/algolia/cli/pkg/cmd/synonyms/lint"
 	"github.com/algolia/cli/pkg/cmd/synonyms/save"
+	"github.com/algolia/cli/pkg/cmd/synonyms/search"
 	"github.com/algolia/cli/pkg/cmdutil"
 )
 
@@ -22,6 +25,9 @@ func NewSynonymsCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(browse.NewBrowseCmd(f))
 	cmd.AddCommand(delete.NewDeleteCmd(f, nil))
 	cmd.AddCommand(save.NewSaveCmd(f, nil))
+	cmd.AddCommand(get.NewGetCmd(f, nil))
+	cmd.AddCommand(search.NewSearchCmd(f, nil))
+	cmd.AddCommand(lint.NewLintCmd(f, nil))
 
 	return cmd
 }
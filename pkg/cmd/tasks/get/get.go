@@ -0,0 +1,117 @@
+package get
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+// GetOptions represents the options for the get command
+type GetOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index  string
+	TaskID int64
+
+	PrintFlags *cmdutil.PrintFlags
+}
+
+// TaskStatus is the status of an indexing task
+type TaskStatus struct {
+	cmdutil.Task
+	Status search.TaskStatus `json:"status"`
+}
+
+// NewGetCmd creates and returns a get command for indexing tasks
+func NewGetCmd(f *cmdutil.Factory, runF func(*GetOptions) error) *cobra.Command {
+	opts := &GetOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		PrintFlags:   cmdutil.NewPrintFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "get <index> <task-id>",
+		Args:              validators.ExactArgs(2),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Short:             "Get the status of an indexing task",
+		Long: heredoc.Doc(`
+			Get the status of an indexing task: "published" if the task is completed, "notPublished" otherwise.
+		`),
+		Example: heredoc.Doc(`
+			# Get the status of the task 123 of the "MOVIES" index
+			$ algolia tasks get MOVIES 123
+
+			# Get the status of the task 123 of the "MOVIES" index as JSON
+			$ algolia tasks get MOVIES 123 -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			taskID, err := cmdutil.ParseTaskID(args[1])
+			if err != nil {
+				return err
+			}
+			opts.TaskID = taskID
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runGetCmd(opts)
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+func runGetCmd(opts *GetOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Fetching task %d", opts.TaskID))
+	res, err := client.GetTask(client.NewApiGetTaskRequest(opts.Index, opts.TaskID))
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.PrintFlags.OutputFlagSpecified() && opts.PrintFlags.OutputFormat != nil {
+		p, err := opts.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		return p.Print(opts.IO, TaskStatus{
+			Task:   cmdutil.Task{Index: opts.Index, TaskID: opts.TaskID},
+			Status: res.Status,
+		})
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		fmt.Fprintln(opts.IO.Out, res.Status)
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	if res.Status == search.TASK_STATUS_PUBLISHED {
+		fmt.Fprintf(opts.IO.Out, "%s Task %d of index %s is published\n", cs.SuccessIcon(), opts.TaskID, opts.Index)
+	} else {
+		fmt.Fprintf(opts.IO.Out, "%s Task %d of index %s is not published yet\n", cs.WarningIcon(), opts.TaskID, opts.Index)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runGetCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		isTTY   bool
+		status  search.TaskStatus
+		wantOut string
+	}{
+		{
+			name:    "no TTY",
+			cli:     "foo 123",
+			status:  search.TASK_STATUS_PUBLISHED,
+			wantOut: "published\n",
+		},
+		{
+			name:    "TTY, published",
+			cli:     "foo 123",
+			isTTY:   true,
+			status:  search.TASK_STATUS_PUBLISHED,
+			wantOut: "✓ Task 123 of index foo is published\n",
+		},
+		{
+			name:    "TTY, not published",
+			cli:     "foo 123",
+			isTTY:   true,
+			status:  search.TASK_STATUS_NOT_PUBLISHED,
+			wantOut: "! Task 123 of index foo is not published yet\n",
+		},
+		{
+			name:    "JSON output",
+			cli:     "foo 123 -o json",
+			status:  search.TASK_STATUS_NOT_PUBLISHED,
+			wantOut: "{\"index\":\"foo\",\"taskID\":123,\"status\":\"notPublished\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httpmock.Registry{}
+			r.Register(
+				httpmock.REST("GET", "1/indexes/foo/task/123"),
+				httpmock.JSONResponse(search.GetTaskResponse{Status: tt.status}),
+			)
+			defer r.Verify(t)
+
+			f, out := test.NewFactory(tt.isTTY, &r, nil, "")
+			cmd := NewGetCmd(f, nil)
+			out, err := test.Execute(cmd, tt.cli, out)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func TestNewGetCmd_invalidTaskID(t *testing.T) {
+	f, out := test.NewFactory(false, nil, nil, "")
+	cmd := NewGetCmd(f, nil)
+	_, err := test.Execute(cmd, "foo bar", out)
+	assert.EqualError(t, err, "invalid task ID \"bar\"")
+}
@@ -0,0 +1,30 @@
+package tasks
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmd/tasks/get"
+	"github.com/algolia/cli/pkg/cmd/tasks/wait"
+	"github.com/algolia/cli/pkg/cmdutil"
+)
+
+// NewTasksCmd returns a new command for indexing tasks.
+func NewTasksCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tasks",
+		Aliases: []string{"task"},
+		Short:   "Inspect and wait for your indexing tasks",
+		Long: heredoc.Doc(`
+			Inspect and wait for the indexing tasks created by write commands.
+
+			Write commands print the tasks they create with "--output json",
+			so that you can wait for them later instead of using their "--wait" flag.
+		`),
+	}
+
+	cmd.AddCommand(get.NewGetCmd(f, nil))
+	cmd.AddCommand(wait.NewWaitCmd(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,131 @@
+package wait
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/cmdutil"
+	"github.com/algolia/cli/pkg/config"
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/utils"
+	"github.com/algolia/cli/pkg/validators"
+)
+
+// WaitOptions represents the options for the wait command
+type WaitOptions struct {
+	Config config.IConfig
+	IO     *iostreams.IOStreams
+
+	SearchClient func() (*search.APIClient, error)
+
+	Index   string
+	TaskIDs []int64
+	Timeout time.Duration
+
+	// Sleep is used to wait between two checks of a task status
+	Sleep func(time.Duration)
+}
+
+// NewWaitCmd creates and returns a wait command for indexing tasks
+func NewWaitCmd(f *cmdutil.Factory, runF func(*WaitOptions) error) *cobra.Command {
+	opts := &WaitOptions{
+		IO:           f.IOStreams,
+		Config:       f.Config,
+		SearchClient: f.SearchClient,
+		Sleep:        time.Sleep,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "wait <index> <task-id>... [--timeout <duration>]",
+		Args:              validators.AtLeastNArgs(2),
+		ValidArgsFunction: cmdutil.IndexNames(opts.SearchClient),
+		Short:             "Wait for indexing tasks to complete",
+		Long: heredoc.Doc(`
+			Wait for one or more indexing tasks of an index to be published.
+
+			The command exits with a non-zero status if the tasks aren't published before the timeout.
+		`),
+		Example: heredoc.Doc(`
+			# Wait for the tasks 123 and 456 of the "MOVIES" index
+			$ algolia tasks wait MOVIES 123 456
+
+			# Wait for the tasks 123 of the "MOVIES" index for up to 5 minutes
+			$ algolia tasks wait MOVIES 123 --timeout 5m
+
+			# Import records without waiting, then wait for the tasks later
+			$ algolia objects import MOVIES -F movies.ndjson -o json > tasks.json
+			$ algolia tasks wait MOVIES $(jq -r '.[].taskID' tasks.json)
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Index = args[0]
+			for _, arg := range args[1:] {
+				taskID, err := cmdutil.ParseTaskID(arg)
+				if err != nil {
+					return err
+				}
+				opts.TaskIDs = append(opts.TaskIDs, taskID)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runWaitCmd(opts)
+		},
+	}
+
+	cmd.Flags().
+		DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "Maximum `duration` to wait for the tasks to complete")
+
+	return cmd
+}
+
+func runWaitCmd(opts *WaitOptions) error {
+	client, err := opts.SearchClient()
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+	for i, taskID := range opts.TaskIDs {
+		opts.IO.StartProgressIndicatorWithLabel(
+			fmt.Sprintf("Waiting for task %d (%d/%d)", taskID, i+1, len(opts.TaskIDs)),
+		)
+		for retry := 1; ; retry++ {
+			res, err := client.GetTask(client.NewApiGetTaskRequest(opts.Index, taskID))
+			if err != nil {
+				opts.IO.StopProgressIndicator()
+				return err
+			}
+			if res.Status == search.TASK_STATUS_PUBLISHED {
+				break
+			}
+
+			// Same backoff as the API client
+			delay := time.Duration(min(200*retry, 5000)) * time.Millisecond
+			if time.Now().Add(delay).After(deadline) {
+				opts.IO.StopProgressIndicator()
+				return fmt.Errorf("timed out after %s waiting for task %d of index %s", opts.Timeout, taskID, opts.Index)
+			}
+			opts.Sleep(delay)
+		}
+		opts.IO.StopProgressIndicator()
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(
+			opts.IO.Out,
+			"%s %s of index %s completed\n",
+			cs.SuccessIcon(),
+			utils.Pluralize(len(opts.TaskIDs), "task"),
+			opts.Index,
+		)
+	}
+
+	return nil
+}
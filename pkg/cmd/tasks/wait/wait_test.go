@@ -0,0 +1,56 @@
+package wait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algolia/cli/pkg/httpmock"
+	"github.com/algolia/cli/test"
+)
+
+func Test_runWaitCmd(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo/task/123"),
+		httpmock.JSONResponse(search.GetTaskResponse{Status: search.TASK_STATUS_NOT_PUBLISHED}),
+	)
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo/task/123"),
+		httpmock.JSONResponse(search.GetTaskResponse{Status: search.TASK_STATUS_PUBLISHED}),
+	)
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo/task/456"),
+		httpmock.JSONResponse(search.GetTaskResponse{Status: search.TASK_STATUS_PUBLISHED}),
+	)
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(true, &r, nil, "")
+	var sleeps []time.Duration
+	cmd := NewWaitCmd(f, func(opts *WaitOptions) error {
+		opts.Sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+		return runWaitCmd(opts)
+	})
+	out, err := test.Execute(cmd, "foo 123 456", out)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ 2 tasks of index foo completed\n", out.String())
+	assert.Equal(t, []time.Duration{200 * time.Millisecond}, sleeps)
+}
+
+func Test_runWaitCmd_timeout(t *testing.T) {
+	r := httpmock.Registry{}
+	r.Register(
+		httpmock.REST("GET", "1/indexes/foo/task/123"),
+		httpmock.JSONResponse(search.GetTaskResponse{Status: search.TASK_STATUS_NOT_PUBLISHED}),
+	)
+	defer r.Verify(t)
+
+	f, out := test.NewFactory(false, &r, nil, "")
+	cmd := NewWaitCmd(f, nil)
+	_, err := test.Execute(cmd, "foo 123 --timeout 100ms", out)
+	assert.EqualError(t, err, "timed out after 100ms waiting for task 123 of index foo")
+}
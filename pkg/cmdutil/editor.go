@@ -0,0 +1,71 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/cli/safeexec"
+	"github.com/google/shlex"
+
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+// EditorCommand returns the command of the user's editor: $VISUAL, $EDITOR or a platform default.
+func EditorCommand() string {
+	if editor := os.Getenv("VISUAL"); editor != "" {
+		return editor
+	}
+	if editor := os.Getenv("EDITOR"); editor != "" {
+		return editor
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// Edit opens the content in the user's editor, in a temporary file matching the pattern
+// (for example, "rule-*.json"), and returns the content once the editor is closed.
+func Edit(io *iostreams.IOStreams, content []byte, pattern string) ([]byte, error) {
+	if !io.CanPrompt() {
+		return nil, fmt.Errorf("the editor can only be opened in an interactive terminal")
+	}
+
+	f, err := io.TempFile("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	args, err := shlex.Split(EditorCommand())
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no editor found, set the $EDITOR environment variable")
+	}
+	exe, err := safeexec.LookPath(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(exe, append(args[1:], f.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run the editor: %w", err)
+	}
+
+	return os.ReadFile(f.Name())
+}
@@ -2,8 +2,15 @@ package cmdutil
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algolia/cli/pkg/utils"
 )
 
 const maxCapacity = 1024 * 5120 // 5MB
@@ -35,3 +42,69 @@ func ScanFile(filename string, stdin io.ReadCloser) (*bufio.Scanner, error) {
 	scanner.Buffer(buffer, maxCapacity)
 	return scanner, nil
 }
+
+// fileExtensionFormats maps the extensions of the files to their format.
+var fileExtensionFormats = map[string]string{
+	".json":   "json",
+	".ndjson": "ndjson",
+	".jsonl":  "ndjson",
+	".yaml":   "yaml",
+	".yml":    "yaml",
+}
+
+// FileFormat returns the format of a file: the format given with the `--format` flag if any,
+// else the format matching the extension of the file if it's one of the allowed formats,
+// else the first allowed format.
+func FileFormat(format string, filename string, allowedFormats ...string) (string, error) {
+	if format != "" {
+		format = strings.ToLower(format)
+		for _, f := range allowedFormats {
+			if f == format {
+				return format, nil
+			}
+		}
+		return "", FlagErrorf(
+			"invalid format %q, expected one of: %s",
+			format,
+			strings.Join(allowedFormats, ", "),
+		)
+	}
+
+	if f, ok := fileExtensionFormats[strings.ToLower(filepath.Ext(filename))]; ok {
+		for _, allowed := range allowedFormats {
+			if f == allowed {
+				return f, nil
+			}
+		}
+	}
+	return allowedFormats[0], nil
+}
+
+// ScanYAMLFile reads a stream of YAML documents, each one being an object or a list of objects,
+// and returns a scanner over the objects converted to JSON, one per line (like an ndjson file).
+func ScanYAMLFile(filename string, stdin io.ReadCloser) (*bufio.Scanner, error) {
+	b, err := ReadFile(filename, stdin)
+	if err != nil {
+		return nil, err
+	}
+	items, err := utils.UnmarshalYAMLList[json.RawMessage](b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse YAML file: %w", err)
+	}
+
+	return NewNDJSONScanner(items), nil
+}
+
+// NewNDJSONScanner returns a scanner over JSON values, one per line (like an ndjson file).
+func NewNDJSONScanner(items []json.RawMessage) *bufio.Scanner {
+	buf := bytes.Buffer{}
+	for _, item := range items {
+		buf.Write(item)
+		buf.WriteByte('\n')
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	buffer := make([]byte, maxCapacity)
+	scanner.Buffer(buffer, maxCapacity)
+	return scanner
+}
@@ -24,6 +24,10 @@ func IsNoCompatiblePrinterError(err error) bool {
 type PrintFlags struct {
 	JSONPrintFlags     *JSONPrintFlags
 	JSONPathPrintFlags *JSONPathPrintFlags
+	YAMLPrintFlags     *YAMLPrintFlags
+
+	// Printers for the output formats specific to a command
+	CustomPrinters map[string]printers.Printer
 
 	OutputFormat        *string
 	OutputFlagSpecified func() bool
@@ -55,6 +59,13 @@ func (f *PrintFlags) AllowedFormats() []string {
 	ret := []string{}
 	ret = append(ret, f.JSONPrintFlags.AllowedFormats()...)
 	ret = append(ret, f.JSONPathPrintFlags.AllowedFormats()...)
+	ret = append(ret, f.YAMLPrintFlags.AllowedFormats()...)
+	custom := make([]string, 0, len(f.CustomPrinters))
+	for format := range f.CustomPrinters {
+		custom = append(custom, format)
+	}
+	sort.Strings(custom)
+	ret = append(ret, custom...)
 	return ret
 }
 
@@ -78,6 +89,16 @@ func (f *PrintFlags) ToPrinter() (printers.Printer, error) {
 		}
 	}
 
+	if p, ok := f.CustomPrinters[strings.ToLower(outputFormat)]; ok {
+		return p, nil
+	}
+
+	if f.YAMLPrintFlags != nil {
+		if p, err := f.YAMLPrintFlags.ToPrinter(outputFormat); !IsNoCompatiblePrinterError(err) {
+			return p, err
+		}
+	}
+
 	return nil, NoCompatiblePrinterError{
 		OutputFormat:   f.OutputFormat,
 		AllowedFormats: f.AllowedFormats(),
@@ -87,6 +108,9 @@ func (f *PrintFlags) ToPrinter() (printers.Printer, error) {
 func (f *PrintFlags) AddFlags(cmd *cobra.Command) {
 	f.JSONPrintFlags.AddFlags(cmd)
 	f.JSONPathPrintFlags.AddFlags(cmd)
+	if f.YAMLPrintFlags != nil {
+		f.YAMLPrintFlags.AddFlags(cmd)
+	}
 
 	if f.OutputFormat != nil {
 		cmd.Flags().
@@ -106,6 +130,21 @@ func (f *PrintFlags) WithDefaultOutput(output string) *PrintFlags {
 	return f
 }
 
+// WithYAMLOutput adds the yaml output format
+func (f *PrintFlags) WithYAMLOutput() *PrintFlags {
+	f.YAMLPrintFlags = NewYAMLPrintFlags()
+	return f
+}
+
+// WithPrinter adds an output format, printed with the given printer
+func (f *PrintFlags) WithPrinter(format string, p printers.Printer) *PrintFlags {
+	if f.CustomPrinters == nil {
+		f.CustomPrinters = map[string]printers.Printer{}
+	}
+	f.CustomPrinters[format] = p
+	return f
+}
+
 // NewPrintFlags returns a default *PrintFlags
 func NewPrintFlags() *PrintFlags {
 	outputFormat := ""
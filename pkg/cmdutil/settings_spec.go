@@ -0,0 +1,33 @@
+package cmdutil
+
+import (
+	"encoding/json"
+)
+
+// SettingSpec is the spec of an index setting, generated from the API spec.
+type SettingSpec struct {
+	// Description is the first sentence of the description of the setting
+	Description string
+	// Default is the JSON-encoded default value of the setting, empty if it has no default value
+	Default string
+	// Types are the allowed JSON types of the setting, empty if any type is allowed
+	Types []string
+	// Enum are the allowed string values of the setting
+	Enum []string
+	// ItemTypes are the allowed JSON types of the elements of array settings
+	ItemTypes []string
+	// ItemEnum are the allowed string values of the elements of array settings
+	ItemEnum []string
+}
+
+// DefaultValue returns the decoded default value of the setting, and false if it has no default value.
+func (s SettingSpec) DefaultValue() (interface{}, bool) {
+	if s.Default == "" {
+		return nil, false
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(s.Default), &v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
@@ -0,0 +1,341 @@
+// This file is generated; DO NOT EDIT.
+
+package cmdutil
+
+// IndexSettingsSpecs are the specs of the index settings.
+var IndexSettingsSpecs = map[string]SettingSpec{
+	"advancedSyntax": {
+		Description: "Whether to support phrase matching and excluding words from search queries.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"advancedSyntaxFeatures": {
+		Description: "Advanced search syntax features you want to support.",
+		Default:     "[\"exactPhrase\",\"excludeWords\"]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"exactPhrase", "excludeWords"},
+	},
+	"allowCompressionOfIntegerArray": {
+		Description: "Whether arrays with exclusively non-negative integers should be compressed for better performance.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"allowTyposOnNumericTokens": {
+		Description: "Whether to allow typos on numbers in the search query.",
+		Default:     "true",
+		Types:       []string{"boolean"},
+	},
+	"alternativesAsExact": {
+		Description: "Determine which plurals and synonyms should be considered an exact matches.",
+		Default:     "[\"ignorePlurals\",\"singleWordSynonym\"]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"ignorePlurals", "singleWordSynonym", "multiWordsSynonym", "ignoreConjugations"},
+	},
+	"attributeCriteriaComputedByMinProximity": {
+		Description: "Whether the best matching attribute should be determined by minimum proximity.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"attributeForDistinct": {
+		Description: "Attribute that should be used to establish groups of results.",
+		Types:       []string{"string"},
+	},
+	"attributesForFaceting": {
+		Description: "Attributes used for faceting.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"attributesToHighlight": {
+		Description: "Attributes to highlight.",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"attributesToRetrieve": {
+		Description: "Attributes to include in the API response.",
+		Default:     "[\"*\"]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"attributesToSnippet": {
+		Description: "Attributes for which to enable snippets.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"attributesToTransliterate": {
+		Description: "Attributes, for which you want to support Japanese transliteration.",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"camelCaseAttributes": {
+		Description: "Attributes for which to split camel case words.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"customNormalization": {
+		Description: "Characters and their normalized replacements.",
+		Types:       []string{"object"},
+	},
+	"customRanking": {
+		Description: "Attributes to use as custom ranking.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"decompoundQuery": {
+		Description: "Whether to split compound words in the query into their building blocks.",
+		Default:     "true",
+		Types:       []string{"boolean"},
+	},
+	"decompoundedAttributes": {
+		Description: "Searchable attributes to which Algolia should apply word segmentation (decompounding).",
+		Default:     "{}",
+		Types:       []string{"object"},
+	},
+	"disableExactOnAttributes": {
+		Description: "Searchable attributes for which you want to turn off the Exact ranking criterion.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"disablePrefixOnAttributes": {
+		Description: "Searchable attributes for which you want to turn off prefix matching.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"disableTypoToleranceOnAttributes": {
+		Description: "Attributes for which you want to turn off typo tolerance.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"disableTypoToleranceOnWords": {
+		Description: "Creates a list of words which require exact matches.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"distinct": {
+		Description: "Determines how many records of a group are included in the search results.",
+		Default:     "0",
+		Types:       []string{"boolean", "integer"},
+	},
+	"enablePersonalization": {
+		Description: "Whether to enable Personalization.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"enableReRanking": {
+		Description: "Whether this search will use Dynamic Re-Ranking.",
+		Default:     "true",
+		Types:       []string{"boolean"},
+	},
+	"enableRules": {
+		Description: "Whether to enable rules.",
+		Default:     "true",
+		Types:       []string{"boolean"},
+	},
+	"exactOnSingleWordQuery": {
+		Description: "Determines how the Exact ranking criterion is computed when the search query has only one word.",
+		Default:     "\"attribute\"",
+		Types:       []string{"string"},
+		Enum:        []string{"attribute", "none", "word"},
+	},
+	"highlightPostTag": {
+		Description: "HTML tag to insert after the highlighted parts in all highlighted results and snippets.",
+		Default:     "\"\\u003c/em\\u003e\"",
+		Types:       []string{"string"},
+	},
+	"highlightPreTag": {
+		Description: "HTML tag to insert before the highlighted parts in all highlighted results and snippets.",
+		Default:     "\"\\u003cem\\u003e\"",
+		Types:       []string{"string"},
+	},
+	"hitsPerPage": {
+		Description: "Number of hits per page.",
+		Default:     "20",
+		Types:       []string{"integer"},
+	},
+	"ignorePlurals": {
+		Description: "Treat singular, plurals, and other forms of declensions as equivalent.",
+		Default:     "false",
+		Types:       []string{"array", "string", "boolean"},
+		Enum:        []string{"true", "false"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"af", "ar", "az", "bg", "bn", "ca", "cs", "cy", "da", "de", "el", "en", "eo", "es", "et", "eu", "fa", "fi", "fo", "fr", "ga", "gl", "he", "hi", "hu", "hy", "id", "is", "it", "ja", "ka", "kk", "ko", "ku", "ky", "lt", "lv", "mi", "mn", "mr", "ms", "mt", "nb", "nl", "no", "ns", "pl", "ps", "pt", "pt-br", "qu", "ro", "ru", "sk", "sq", "sv", "sw", "ta", "te", "th", "tl", "tn", "tr", "tt", "uk", "ur", "uz", "zh"},
+	},
+	"indexLanguages": {
+		Description: "Languages for language-specific processing steps, such as word detection and dictionary settings.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"af", "ar", "az", "bg", "bn", "ca", "cs", "cy", "da", "de", "el", "en", "eo", "es", "et", "eu", "fa", "fi", "fo", "fr", "ga", "gl", "he", "hi", "hu", "hy", "id", "is", "it", "ja", "ka", "kk", "ko", "ku", "ky", "lt", "lv", "mi", "mn", "mr", "ms", "mt", "nb", "nl", "no", "ns", "pl", "ps", "pt", "pt-br", "qu", "ro", "ru", "sk", "sq", "sv", "sw", "ta", "te", "th", "tl", "tn", "tr", "tt", "uk", "ur", "uz", "zh"},
+	},
+	"keepDiacriticsOnCharacters": {
+		Description: "Characters for which diacritics should be preserved.",
+		Default:     "\"\"",
+		Types:       []string{"string"},
+	},
+	"maxFacetHits": {
+		Description: "Maximum number of facet values to return when searching for facet values.",
+		Default:     "10",
+		Types:       []string{"integer"},
+	},
+	"maxValuesPerFacet": {
+		Description: "Maximum number of facet values to return for each facet.",
+		Default:     "100",
+		Types:       []string{"integer"},
+	},
+	"minProximity": {
+		Description: "Minimum proximity score for two matching words.",
+		Default:     "1",
+		Types:       []string{"integer"},
+	},
+	"minWordSizefor1Typo": {
+		Description: "Minimum number of characters a word in the search query must contain to accept matches with one typo.",
+		Default:     "4",
+		Types:       []string{"integer"},
+	},
+	"minWordSizefor2Typos": {
+		Description: "Minimum number of characters a word in the search query must contain to accept matches with two typos.",
+		Default:     "8",
+		Types:       []string{"integer"},
+	},
+	"mode": {
+		Description: "Search mode the index will use to query for results.",
+		Default:     "\"keywordSearch\"",
+		Types:       []string{"string"},
+		Enum:        []string{"neuralSearch", "keywordSearch"},
+	},
+	"numericAttributesForFiltering": {
+		Description: "Numeric attributes that can be used as numerical filters.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"optionalWords": {
+		Description: "Words that should be considered optional when found in the query.",
+		Default:     "[]",
+		Types:       []string{"string", "null", "array"},
+		ItemTypes:   []string{"string"},
+	},
+	"paginationLimitedTo": {
+		Description: "Maximum number of search results that can be obtained through pagination.",
+		Default:     "1000",
+		Types:       []string{"integer"},
+	},
+	"queryLanguages": {
+		Description: "Languages for language-specific query processing steps such as plurals, stop-word removal, and word-detection dictionaries.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"af", "ar", "az", "bg", "bn", "ca", "cs", "cy", "da", "de", "el", "en", "eo", "es", "et", "eu", "fa", "fi", "fo", "fr", "ga", "gl", "he", "hi", "hu", "hy", "id", "is", "it", "ja", "ka", "kk", "ko", "ku", "ky", "lt", "lv", "mi", "mn", "mr", "ms", "mt", "nb", "nl", "no", "ns", "pl", "ps", "pt", "pt-br", "qu", "ro", "ru", "sk", "sq", "sv", "sw", "ta", "te", "th", "tl", "tn", "tr", "tt", "uk", "ur", "uz", "zh"},
+	},
+	"queryType": {
+		Description: "Determines if and how query words are interpreted as prefixes.",
+		Default:     "\"prefixLast\"",
+		Types:       []string{"string"},
+		Enum:        []string{"prefixLast", "prefixAll", "prefixNone"},
+	},
+	"ranking": {
+		Description: "Determines the order in which Algolia returns your results.",
+		Default:     "[\"typo\",\"geo\",\"words\",\"filters\",\"proximity\",\"attribute\",\"exact\",\"custom\"]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"reRankingApplyFilter": {
+		Description: "Restrict Dynamic Re-Ranking to records that match these filters.",
+		Types:       []string{"array", "string", "null"},
+	},
+	"relevancyStrictness": {
+		Description: "Relevancy threshold below which less relevant results aren't included in the results.",
+		Default:     "100",
+		Types:       []string{"integer"},
+	},
+	"removeStopWords": {
+		Description: "Removes stop words from the search query.",
+		Default:     "false",
+		Types:       []string{"array", "boolean"},
+		ItemTypes:   []string{"string"},
+		ItemEnum:    []string{"af", "ar", "az", "bg", "bn", "ca", "cs", "cy", "da", "de", "el", "en", "eo", "es", "et", "eu", "fa", "fi", "fo", "fr", "ga", "gl", "he", "hi", "hu", "hy", "id", "is", "it", "ja", "ka", "kk", "ko", "ku", "ky", "lt", "lv", "mi", "mn", "mr", "ms", "mt", "nb", "nl", "no", "ns", "pl", "ps", "pt", "pt-br", "qu", "ro", "ru", "sk", "sq", "sv", "sw", "ta", "te", "th", "tl", "tn", "tr", "tt", "uk", "ur", "uz", "zh"},
+	},
+	"removeWordsIfNoResults": {
+		Description: "Strategy for removing words from the query when it doesn't return any results.",
+		Default:     "\"none\"",
+		Types:       []string{"string"},
+		Enum:        []string{"none", "lastWords", "firstWords", "allOptional"},
+	},
+	"renderingContent": {
+		Description: "Extra data that can be used in the search UI.",
+		Types:       []string{"object"},
+	},
+	"replaceSynonymsInHighlight": {
+		Description: "Whether to replace a highlighted word with the matched synonym.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"replicas": {
+		Description: "Creates replica indices.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"responseFields": {
+		Description: "Properties to include in the API response of search and browse requests.",
+		Default:     "[\"*\"]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"restrictHighlightAndSnippetArrays": {
+		Description: "Whether to restrict highlighting and snippeting to items that at least partially matched the search query.",
+		Default:     "false",
+		Types:       []string{"boolean"},
+	},
+	"searchableAttributes": {
+		Description: "Attributes used for searching. Attribute names are case-sensitive.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"semanticSearch": {
+		Description: "Settings for the semantic search part of NeuralSearch.",
+		Types:       []string{"object"},
+	},
+	"separatorsToIndex": {
+		Description: "Control which non-alphanumeric characters are indexed.",
+		Default:     "\"\"",
+		Types:       []string{"string"},
+	},
+	"snippetEllipsisText": {
+		Description: "String used as an ellipsis indicator when a snippet is truncated.",
+		Default:     "\"…\"",
+		Types:       []string{"string"},
+	},
+	"sortFacetValuesBy": {
+		Description: "Order in which to retrieve facet values.",
+		Default:     "\"count\"",
+		Types:       []string{"string"},
+	},
+	"typoTolerance": {
+		Description: "Whether typo tolerance is enabled and how it is applied.",
+		Default:     "true",
+		Types:       []string{"boolean", "string"},
+		Enum:        []string{"min", "strict"},
+	},
+	"unretrievableAttributes": {
+		Description: "Attributes that can't be retrieved at query time.",
+		Default:     "[]",
+		Types:       []string{"array"},
+		ItemTypes:   []string{"string"},
+	},
+	"userData": {
+		Description: "An object with custom data.",
+		Default:     "{}",
+		Types:       []string{"object"},
+	},
+}
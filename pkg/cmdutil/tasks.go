@@ -0,0 +1,45 @@
+package cmdutil
+
+import (
+	"strconv"
+
+	"github.com/algolia/cli/pkg/iostreams"
+)
+
+// Task is an indexing task created by a write command.
+// Write commands print their tasks with the `--output` flag,
+// so that they can be checked later with `algolia tasks`.
+type Task struct {
+	Index  string `json:"index"`
+	TaskID int64  `json:"taskID"`
+}
+
+// IndexTasks returns the tasks with the given IDs, all created on the same index.
+func IndexTasks(index string, taskIDs []int64) []Task {
+	tasks := make([]Task, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		tasks = append(tasks, Task{Index: index, TaskID: taskID})
+	}
+	return tasks
+}
+
+// PrintTasks prints the tasks created by a write command in the format of the print flags.
+func PrintTasks(io *iostreams.IOStreams, f *PrintFlags, tasks []Task) error {
+	p, err := f.ToPrinter()
+	if err != nil {
+		return err
+	}
+	if tasks == nil {
+		tasks = []Task{}
+	}
+	return p.Print(io, tasks)
+}
+
+// ParseTaskID parses a task ID given as a command argument.
+func ParseTaskID(arg string) (int64, error) {
+	taskID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || taskID < 0 {
+		return 0, FlagErrorf("invalid task ID %q", arg)
+	}
+	return taskID, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/algolia/cli/api/crawler"
+	"github.com/algolia/cli/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -51,3 +52,73 @@ func CrawlerIDs(
 		return names, cobra.ShellCompDirectiveNoFileComp
 	}
 }
+
+// RuleIDs returns a function to complete the index name as the first argument,
+// then the IDs of the rules of this index.
+func RuleIDs(
+	clientF func() (*search.APIClient, error),
+) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return IndexNames(clientF)(cmd, args, toComplete)
+		}
+
+		client, err := clientF()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		res, err := client.SearchRules(
+			client.NewApiSearchRulesRequest(args[0]).
+				WithSearchRulesParams(search.NewEmptySearchRulesParams().SetHitsPerPage(1000)),
+		)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		ids := make([]string, 0, len(res.Hits))
+		for _, rule := range res.Hits {
+			if utils.Contains(args[1:], rule.ObjectID) {
+				continue
+			}
+			if rule.Description != nil && *rule.Description != "" {
+				ids = append(ids, fmt.Sprintf("%s\t%s", rule.ObjectID, *rule.Description))
+			} else {
+				ids = append(ids, rule.ObjectID)
+			}
+		}
+		return ids, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// SynonymIDs returns a function to complete the index name as the first argument,
+// then the IDs of the synonyms of this index.
+func SynonymIDs(
+	clientF func() (*search.APIClient, error),
+) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return IndexNames(clientF)(cmd, args, toComplete)
+		}
+
+		client, err := clientF()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		res, err := client.SearchSynonyms(
+			client.NewApiSearchSynonymsRequest(args[0]).
+				WithSearchSynonymsParams(search.NewEmptySearchSynonymsParams().SetHitsPerPage(1000)),
+		)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		ids := make([]string, 0, len(res.Hits))
+		for _, synonym := range res.Hits {
+			if utils.Contains(args[1:], synonym.ObjectID) {
+				continue
+			}
+			ids = append(ids, fmt.Sprintf("%s\t%s", synonym.ObjectID, synonym.Type))
+		}
+		return ids, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,37 @@
+package cmdutil
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algolia/cli/pkg/printers"
+)
+
+func (f *YAMLPrintFlags) AllowedFormats() []string {
+	if f == nil {
+		return []string{}
+	}
+	return []string{"yaml"}
+}
+
+// YAMLPrintFlags provides the yaml output format, for the commands that can read it back.
+type YAMLPrintFlags struct{}
+
+func (f *YAMLPrintFlags) ToPrinter(outputFormat string) (printers.Printer, error) {
+	outputFormat = strings.ToLower(outputFormat)
+	if outputFormat != "yaml" {
+		return nil, NoCompatiblePrinterError{
+			OutputFormat:   &outputFormat,
+			AllowedFormats: f.AllowedFormats(),
+		}
+	}
+
+	return &printers.YAMLPrinter{}, nil
+}
+
+func (f *YAMLPrintFlags) AddFlags(c *cobra.Command) {}
+
+func NewYAMLPrintFlags() *YAMLPrintFlags {
+	return &YAMLPrintFlags{}
+}
@@ -10,6 +10,7 @@ import (
 	"go/format"
 	"io/ioutil"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -18,6 +19,8 @@ import (
 
 type TemplateData struct {
 	SpecFlags map[string]*SpecFlags
+	// SettingSpecs are the specs of the index settings
+	SettingSpecs map[string]*SettingSpec
 }
 
 type SpecFlags struct {
@@ -34,11 +37,25 @@ type SpecFlag struct {
 	Categories []string
 }
 
+// SettingSpec is the spec of an index setting, used for validation and to find default values.
+type SettingSpec struct {
+	Description string
+	Default     string
+	Types       []string
+	Enum        []string
+	ItemTypes   []string
+	ItemEnum    []string
+}
+
 const (
 	searchSpecFile = "../../../api/specs/search.yml"
 	pathTemplate   = "../../gen/flags.go.tpl"
 	pathName       = "flags.go.tpl"
 	pathOutput     = "../../cmdutil/spec_flags.go"
+
+	settingsPathTemplate = "../../gen/settings.go.tpl"
+	settingsPathName     = "settings.go.tpl"
+	settingsPathOutput   = "../../cmdutil/spec_settings.go"
 )
 
 func main() {
@@ -56,36 +73,46 @@ func main() {
 		panic(err)
 	}
 
+	err = generate(pathTemplate, pathName, pathOutput, templateData)
+	if err != nil {
+		panic(err)
+	}
+	err = generate(settingsPathTemplate, settingsPathName, settingsPathOutput, templateData)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// generate executes a template with the given data, and writes the formatted result to disk.
+func generate(templatePath, templateName, outputPath string, data TemplateData) error {
 	// Load the template with a custom function map
 	tmpl := template.Must(template.
 		// Note that the template name MUST match the file name
-		New(pathName).
+		New(templateName).
 		Funcs(template.FuncMap{
 			"capitalize": func(s string) string {
 				return strings.Title(s)
 			},
+			"quote": strconv.Quote,
 		}).
-		ParseFiles(pathTemplate))
+		ParseFiles(templatePath))
 
 	// Execute the template
 	var result bytes.Buffer
-	err = tmpl.Execute(&result, templateData)
+	err := tmpl.Execute(&result, data)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	// Format the output of the template execution
 	formatted, err := format.Source(result.Bytes())
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	// Write the formatted source code to disk
-	fmt.Printf("writing %s\n", pathOutput)
-	err = ioutil.WriteFile(pathOutput, formatted, 0o644)
-	if err != nil {
-		panic(err)
-	}
+	fmt.Printf("writing %s\n", outputPath)
+	return ioutil.WriteFile(outputPath, formatted, 0o644)
 }
 
 // loadProperties recursively loads the properties of the given schemaRef.
@@ -143,9 +170,73 @@ func getTemplateData(specNames []string) (TemplateData, error) {
 		}
 		data.SpecFlags[specName] = getFlags(specParams)
 	}
+
+	settingsParams, err := loadSpecs(searchSpecFile, "indexSettings")
+	if err != nil {
+		return *data, err
+	}
+	data.SettingSpecs = make(map[string]*SettingSpec)
+	for name, param := range settingsParams {
+		data.SettingSpecs[name] = getSettingSpec(param)
+	}
 	return *data, nil
 }
 
+// getSettingSpec returns the spec of an index setting.
+// Only the top-level type is described: the properties of objects aren't checked.
+func getSettingSpec(param *openapi3.Schema) *SettingSpec {
+	spec := &SettingSpec{}
+
+	var def interface{}
+	for _, schema := range flattenOneOf(param) {
+		if spec.Description == "" && schema.Description != "" {
+			spec.Description = shortDescription(schema.Description)
+		}
+		if def == nil {
+			def = schema.Default
+		}
+		if schema.Type != "" {
+			spec.Types = append(spec.Types, schema.Type)
+		}
+		spec.Enum = append(spec.Enum, enumValues(schema.Enum)...)
+		if schema.Type == "array" && schema.Items != nil {
+			if schema.Items.Value.Type != "" {
+				spec.ItemTypes = append(spec.ItemTypes, schema.Items.Value.Type)
+			}
+			spec.ItemEnum = append(spec.ItemEnum, enumValues(schema.Items.Value.Enum)...)
+		}
+	}
+
+	if def != nil {
+		b, err := json.Marshal(def)
+		if err == nil {
+			spec.Default = string(b)
+		}
+	}
+
+	return spec
+}
+
+// flattenOneOf returns the schema and the alternatives of its (nested) oneOf.
+func flattenOneOf(param *openapi3.Schema) []*openapi3.Schema {
+	schemas := []*openapi3.Schema{param}
+	for _, oneOf := range param.OneOf {
+		schemas = append(schemas, flattenOneOf(oneOf.Value)...)
+	}
+	return schemas
+}
+
+// enumValues returns the string values of an enum.
+func enumValues(enum []interface{}) []string {
+	var values []string
+	for _, e := range enum {
+		if v, ok := e.(string); ok {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // getFlags returns the flags for the given spec.
 func getFlags(params map[string]*openapi3.Schema) *SpecFlags {
 	flags := &SpecFlags{
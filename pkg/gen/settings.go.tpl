@@ -0,0 +1,15 @@
+// This file is generated; DO NOT EDIT.
+
+package cmdutil
+
+// IndexSettingsSpecs are the specs of the index settings.
+var IndexSettingsSpecs = map[string]SettingSpec{
+{{ range $name, $spec := .SettingSpecs }}    "{{ $name }}": {
+        Description: {{ quote $spec.Description }},{{ if $spec.Default }}
+        Default: {{ quote $spec.Default }},{{ end }}{{ if $spec.Types }}
+        Types: []string{ {{ range $t := $spec.Types }}"{{ $t }}", {{ end }} },{{ end }}{{ if $spec.Enum }}
+        Enum: []string{ {{ range $e := $spec.Enum }}"{{ $e }}", {{ end }} },{{ end }}{{ if $spec.ItemTypes }}
+        ItemTypes: []string{ {{ range $t := $spec.ItemTypes }}"{{ $t }}", {{ end }} },{{ end }}{{ if $spec.ItemEnum }}
+        ItemEnum: []string{ {{ range $e := $spec.ItemEnum }}"{{ $e }}", {{ end }} },{{ end }}
+    },
+{{ end }}}
@@ -0,0 +1,26 @@
+package printers
+
+import (
+	"github.com/algolia/cli/pkg/iostreams"
+	"github.com/algolia/cli/pkg/utils"
+)
+
+// YAMLPrinter is an implementation of Printer which outputs an object as a YAML document.
+var _ Printer = &YAMLPrinter{}
+
+type YAMLPrinter struct{}
+
+// Print writes the object as a YAML document, starting with a `---` separator
+// so that the objects printed one after the other form a valid stream of documents.
+func (p *YAMLPrinter) Print(ios *iostreams.IOStreams, data interface{}) error {
+	b, err := utils.MarshalYAML(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ios.Out.Write([]byte("---\n")); err != nil {
+		return err
+	}
+	_, err = ios.Out.Write(b)
+	return err
+}
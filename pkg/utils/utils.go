@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // Pluralize returns the plural form of a given string
@@ -110,3 +111,19 @@ func SliceToReadableString(str []string) string {
 
 	return readableStr
 }
+
+// ForEach calls fn for each index from 0 to n-1, with at most `concurrency` calls at the same time.
+func ForEach(n int, concurrency int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
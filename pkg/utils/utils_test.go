@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -240,3 +241,29 @@ func TestSliceToReadableString(t *testing.T) {
 		})
 	}
 }
+
+func TestForEach(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		running int
+		maxSeen int
+	)
+	visited := make([]bool, 10)
+	ForEach(len(visited), 3, func(i int) {
+		mu.Lock()
+		running++
+		maxSeen = max(maxSeen, running)
+		mu.Unlock()
+
+		visited[i] = true
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+
+	for i, v := range visited {
+		assert.True(t, v, "index %d not visited", i)
+	}
+	assert.LessOrEqual(t, maxSeen, 3)
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML encodes a value as a block-style YAML document.
+// The value is encoded as JSON first, so that the `json` tags and the custom JSON marshalers
+// of the API client are used, and the keys are kept in the order of the JSON encoding.
+func MarshalYAML(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML: decoding it as a node keeps the order of the keys
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	buf := bytes.Buffer{}
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle removes the flow style and the quotes of the JSON encoding.
+// Strings that would be read as another type (like "true" or "42") are still quoted by the encoder.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetStyle(n)
+	}
+}
+
+// YAMLToJSON converts a stream of YAML documents to JSON, one JSON value per document.
+func YAMLToJSON(data []byte) ([]json.RawMessage, error) {
+	var docs []json.RawMessage
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var v interface{}
+		err := decoder.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			// Empty document
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("document %d can't be converted to JSON: %w", i, err)
+		}
+		docs = append(docs, b)
+	}
+
+	return docs, nil
+}
+
+// UnmarshalYAMLList decodes a stream of YAML documents into a list of values.
+// Each document is either a single value or a list of values.
+func UnmarshalYAMLList[T any](data []byte) ([]T, error) {
+	docs, err := YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []T{}
+	for _, doc := range docs {
+		if bytes.HasPrefix(doc, []byte("[")) {
+			var list []T
+			if err := json.Unmarshal(doc, &list); err != nil {
+				return nil, err
+			}
+			items = append(items, list...)
+			continue
+		}
+		var item T
+		if err := json.Unmarshal(doc, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MarshalYAML(t *testing.T) {
+	rule := search.NewEmptyRule().
+		SetObjectID("promote-42").
+		SetConditions([]search.Condition{
+			*search.NewEmptyCondition().SetPattern("true").SetAnchoring(search.ANCHORING_IS),
+		}).
+		SetConsequence(search.NewEmptyConsequence().SetUserData(map[string]any{
+			"zebra": 1,
+			"apple": "2024-01-01",
+		}))
+
+	b, err := MarshalYAML(rule)
+	require.NoError(t, err)
+
+	assert.Equal(t, `conditions:
+  - anchoring: is
+    pattern: "true"
+consequence:
+  userData:
+    apple: "2024-01-01"
+    zebra: 1
+objectID: promote-42
+`, string(b))
+
+	// Round trip
+	docs, err := YAMLToJSON(b)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	var got search.Rule
+	require.NoError(t, json.Unmarshal(docs[0], &got))
+	assert.Equal(t, "true", got.Conditions[0].GetPattern())
+	assert.Equal(t, "2024-01-01", got.Consequence.UserData["apple"])
+}
+
+func Test_UnmarshalYAMLList(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "list",
+			yaml: "- objectID: a\n- objectID: b\n",
+			want: []string{"a", "b"},
+		},
+		{
+			name: "documents",
+			yaml: "---\nobjectID: a\n---\nobjectID: b\n",
+			want: []string{"a", "b"},
+		},
+		{
+			name: "documents and lists",
+			yaml: "objectID: a\n---\n- objectID: b\n- objectID: c\n---\n",
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "empty",
+			yaml: "",
+			want: []string{},
+		},
+		{
+			name:    "invalid",
+			yaml:    "objectID: [a",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := UnmarshalYAMLList[search.SynonymHit]([]byte(tt.yaml))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, item := range items {
+				ids = append(ids, item.ObjectID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}